@@ -0,0 +1,58 @@
+package main
+
+import (
+	"MScannot206/pkg/reward"
+	"MScannot206/shared/table"
+	"flag"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func main() {
+	var dataPath = flag.String("data", "data", "데이터 테이블(CSV) 디렉토리 경로 지정")
+	var groupIndex = flag.String("group", "", "시뮬레이션할 보상 그룹 인덱스")
+	var draws = flag.Int("n", 10000, "뽑기 횟수")
+	var seed = flag.Uint64("seed", 0, "랜덤 시드 (0이면 현재 시각)")
+	flag.Parse()
+
+	if *groupIndex == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+
+	tableRepo := &table.Repository{}
+	if err := tableRepo.Load(*dataPath); err != nil {
+		fmt.Fprintf(os.Stderr, "데이터 테이블 로드 오류 [path:%v]: %v\n", *dataPath, err)
+		os.Exit(1)
+	}
+
+	if *seed == 0 {
+		*seed = uint64(time.Now().UnixNano())
+	}
+
+	simulation, err := reward.Simulate(tableRepo, *groupIndex, *draws, rand.New(rand.NewPCG(*seed, *seed)))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "보상 시뮬레이션 오류 [group:%v]: %v\n", *groupIndex, err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("보상 그룹: %v, 뽑기 횟수: %d, 천장 지급: %d, 시드: %d\n", simulation.GroupIndex, simulation.Draws, simulation.PityDraws, *seed)
+	fmt.Printf("%-32s %12s %12s %10s\n", "아이템", "설정", "관측", "차이")
+	for _, item := range simulation.Items {
+		fmt.Printf("%-32s %12.6f %12.6f %+9.2f%%\n", item.Index, item.Expected, item.Observed, diffPercent(item.Expected, item.Observed))
+	}
+}
+
+// 설정값 대비 관측값의 차이(%)
+func diffPercent(expected float64, observed float64) float64 {
+	if expected == 0 {
+		return 0
+	}
+	return (observed - expected) / expected * 100
+}
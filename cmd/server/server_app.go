@@ -3,10 +3,15 @@ package main
 import (
 	"MScannot206/pkg/api"
 	"MScannot206/pkg/auth"
+	"MScannot206/pkg/auth/identity"
 	"MScannot206/pkg/auth/session"
 	"MScannot206/pkg/channel"
+	"MScannot206/pkg/datatable"
+	"MScannot206/pkg/inventory"
 	"MScannot206/pkg/login"
+	"MScannot206/pkg/minigame/clicker"
 	"MScannot206/pkg/random"
+	"MScannot206/pkg/reward"
 	"MScannot206/pkg/serverinfo"
 	"MScannot206/pkg/user"
 	"MScannot206/shared/config"
@@ -21,6 +26,7 @@ import (
 	"os/signal"
 	"path/filepath"
 	"syscall"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -74,7 +80,7 @@ func setupConfig(
 	return errs
 }
 
-func setupServices(server *server.WebServer, cfg *config.WebServerConfig, tableRepo *table.Repository) error {
+func setupServices(server *server.WebServer, cfg *config.WebServerConfig, tableRepo *table.Repository, dataPath string) error {
 	var errs error
 
 	// 서비스 생성
@@ -86,17 +92,44 @@ func setupServices(server *server.WebServer, cfg *config.WebServerConfig, tableR
 		log.Error().Err(err).Msg("서버정보 서비스 생성 오류")
 	}
 
-	randomService, err := random.NewRandomService()
+	// 데이터 테이블 서비스
+	dataTableService, err := datatable.NewDataTableService(
+		tableRepo,
+		dataPath,
+		cfg.AdminKey,
+		time.Duration(cfg.DataTableWatchInterval)*time.Second,
+	)
+	if err != nil {
+		errs = errors.Join(errs, err)
+		log.Error().Err(err).Msg("데이터 테이블 서비스 생성 오류")
+	}
+
+	randomService, err := random.NewRandomService(cfg.RandomLogDraws)
 	if err != nil {
 		errs = errors.Join(errs, err)
 		log.Error().Err(err).Msg("랜덤 서비스 생성 오류")
 	}
 
 	// 인증 서비스
-	authService, err := auth.NewAuthService()
+	tokenKeys := make([]*session.TokenKey, 0, len(cfg.AuthTokenKeys))
+	for _, key := range cfg.AuthTokenKeys {
+		tokenKeys = append(tokenKeys, &session.TokenKey{
+			Id:     key.Id,
+			Secret: []byte(key.Secret),
+		})
+	}
+
+	authService, err := auth.NewAuthService(auth.AuthServiceConfig{
+		AccessTokenTtlSeconds: cfg.AuthAccessTokenTtlSeconds,
+		RefreshTokenTtlHours:  cfg.AuthRefreshTokenTtlHours,
+		TokenKeys:             tokenKeys,
+	})
 	if err != nil {
 		errs = errors.Join(errs, err)
 		log.Error().Err(err).Msg("인증 서비스 생성 오류")
+	} else if err := setupIdentityProviders(authService, cfg); err != nil {
+		errs = errors.Join(errs, err)
+		log.Error().Err(err).Msg("인증 수단 설정 오류")
 	}
 
 	// 로그인 서비스
@@ -107,7 +140,13 @@ func setupServices(server *server.WebServer, cfg *config.WebServerConfig, tableR
 	}
 
 	// 유저 서비스
-	userService, err := user.NewUserService(tableRepo)
+	userService, err := user.NewUserService(tableRepo, user.UserServiceConfig{
+		CharacterDeleteGraceHours:    cfg.CharacterDeleteGraceHours,
+		CharacterRenameCooldownHours: cfg.CharacterRenameCooldownHours,
+		CharacterSlotDefault:         cfg.CharacterSlotDefault,
+		CharacterSlotExpandItem:      cfg.CharacterSlotExpandItem,
+		CharacterSlotExpandItemCount: cfg.CharacterSlotExpandItemCount,
+	})
 	if err != nil {
 		errs = errors.Join(errs, err)
 		log.Error().Err(err).Msg("유저 서비스 생성 오류")
@@ -120,6 +159,27 @@ func setupServices(server *server.WebServer, cfg *config.WebServerConfig, tableR
 		log.Error().Err(err).Msg("채널 서비스 생성 오류")
 	}
 
+	// 인벤토리 서비스
+	inventoryService, err := inventory.NewInventoryService()
+	if err != nil {
+		errs = errors.Join(errs, err)
+		log.Error().Err(err).Msg("인벤토리 서비스 생성 오류")
+	}
+
+	// 클리커 미니게임 서비스
+	clickerService, err := clicker.NewClickerService(cfg.ClickerMaxClicksPerSecond)
+	if err != nil {
+		errs = errors.Join(errs, err)
+		log.Error().Err(err).Msg("클리커 서비스 생성 오류")
+	}
+
+	// 보상 서비스
+	rewardService, err := reward.NewRewardService()
+	if err != nil {
+		errs = errors.Join(errs, err)
+		log.Error().Err(err).Msg("보상 서비스 생성 오류")
+	}
+
 	if errs != nil {
 		return errs
 	}
@@ -139,6 +199,12 @@ func setupServices(server *server.WebServer, cfg *config.WebServerConfig, tableR
 		log.Error().Err(err).Msg("세션 레포지토리 생성 오류")
 	}
 
+	identityRepo, err := identity.NewIdentityRepository(server.GetContext(), server.GetMongoClient(), gameDBName)
+	if err != nil {
+		errs = errors.Join(errs, err)
+		log.Error().Err(err).Msg("인증 정보 레포지토리 생성 오류")
+	}
+
 	userRepo, err := user.NewUserMongoRepository(server.GetContext(), server.GetMongoClient(), gameDBName)
 	if err != nil {
 		errs = errors.Join(errs, err)
@@ -151,6 +217,24 @@ func setupServices(server *server.WebServer, cfg *config.WebServerConfig, tableR
 		log.Error().Err(err).Msg("채널 레포지토리 생성 오류")
 	}
 
+	inventoryRepo, err := inventory.NewInventoryMongoRepository(server.GetContext(), server.GetMongoClient(), gameDBName)
+	if err != nil {
+		errs = errors.Join(errs, err)
+		log.Error().Err(err).Msg("인벤토리 레포지토리 생성 오류")
+	}
+
+	randomRepo, err := random.NewRandomMongoRepository(server.GetContext(), server.GetMongoClient(), gameDBName)
+	if err != nil {
+		errs = errors.Join(errs, err)
+		log.Error().Err(err).Msg("랜덤 레포지토리 생성 오류")
+	}
+
+	rewardRepo, err := reward.NewRewardMongoRepository(server.GetContext(), server.GetMongoClient(), gameDBName)
+	if err != nil {
+		errs = errors.Join(errs, err)
+		log.Error().Err(err).Msg("보상 레포지토리 생성 오류")
+	}
+
 	if errs != nil {
 		return errs
 	}
@@ -158,11 +242,26 @@ func setupServices(server *server.WebServer, cfg *config.WebServerConfig, tableR
 	// 핸들러 바인드
 	errs = nil
 
-	if err := userService.SetHandlers(randomService); err != nil {
+	if err := userService.SetHandlers(randomService, inventoryService); err != nil {
 		errs = errors.Join(errs, err)
 		log.Error().Err(err).Msg("유저 서비스 핸들러 설정 오류")
 	}
 
+	if err := clickerService.SetHandlers(randomService, inventoryService); err != nil {
+		errs = errors.Join(errs, err)
+		log.Error().Err(err).Msg("클리커 서비스 핸들러 설정 오류")
+	}
+
+	if err := rewardService.SetHandlers(randomService); err != nil {
+		errs = errors.Join(errs, err)
+		log.Error().Err(err).Msg("보상 서비스 핸들러 설정 오류")
+	}
+
+	if err := dataTableService.SetHandlers(userService, inventoryService, clickerService, rewardService); err != nil {
+		errs = errors.Join(errs, err)
+		log.Error().Err(err).Msg("데이터 테이블 서비스 핸들러 설정 오류")
+	}
+
 	if errs != nil {
 		return errs
 	}
@@ -170,7 +269,12 @@ func setupServices(server *server.WebServer, cfg *config.WebServerConfig, tableR
 	// 레포지토리 바인드
 	err = nil
 
-	if err := authService.SetRepositories(sessionRepo); err != nil {
+	if err := randomService.SetRepositories(randomRepo); err != nil {
+		errs = errors.Join(errs, err)
+		log.Error().Err(err).Msg("랜덤 서비스 레포지토리 설정 오류")
+	}
+
+	if err := authService.SetRepositories(sessionRepo, identityRepo); err != nil {
 		errs = errors.Join(errs, err)
 		log.Error().Err(err).Msg("인증 서비스 레포지토리 설정 오류")
 	}
@@ -190,6 +294,21 @@ func setupServices(server *server.WebServer, cfg *config.WebServerConfig, tableR
 		log.Error().Err(err).Msg("채널 서비스 레포지토리 설정 오류")
 	}
 
+	if err := inventoryService.SetRepositories(tableRepo, inventoryRepo, userRepo); err != nil {
+		errs = errors.Join(errs, err)
+		log.Error().Err(err).Msg("인벤토리 서비스 레포지토리 설정 오류")
+	}
+
+	if err := rewardService.SetRepositories(tableRepo, rewardRepo); err != nil {
+		errs = errors.Join(errs, err)
+		log.Error().Err(err).Msg("보상 서비스 레포지토리 설정 오류")
+	}
+
+	if err := clickerService.SetRepositories(tableRepo); err != nil {
+		errs = errors.Join(errs, err)
+		log.Error().Err(err).Msg("클리커 서비스 레포지토리 설정 오류")
+	}
+
 	if errs != nil {
 		return errs
 	}
@@ -198,11 +317,15 @@ func setupServices(server *server.WebServer, cfg *config.WebServerConfig, tableR
 	errs = nil
 	for _, svc := range []service.Service{
 		serverInfoService,
+		dataTableService,
 		randomService,
 		authService,
 		userService,
 		loginService,
 		channelService,
+		inventoryService,
+		clickerService,
+		rewardService,
 	} {
 		if err := server.AddService(svc); err != nil {
 			errs = errors.Join(errs, err)
@@ -213,6 +336,66 @@ func setupServices(server *server.WebServer, cfg *config.WebServerConfig, tableR
 	return errs
 }
 
+// 설정에 따라 인증 수단을 등록합니다
+func setupIdentityProviders(authService *auth.AuthService, cfg *config.WebServerConfig) error {
+	providerNames := cfg.AuthProviders
+	if len(providerNames) == 0 {
+		providerNames = []string{identity.ProviderPassword, identity.ProviderDevice}
+	}
+
+	var errs error
+
+	for _, name := range providerNames {
+		var provider identity.Provider
+		var err error
+
+		switch name {
+		case identity.ProviderPassword:
+			var hasher identity.PasswordHasher
+			hasher, err = identity.NewPasswordHasher(cfg.AuthPasswordHasher)
+			if err == nil {
+				provider, err = identity.NewPasswordProvider(hasher)
+			}
+		case identity.ProviderDevice:
+			provider, err = identity.NewDeviceProvider()
+		case identity.ProviderLegacy:
+			// 이전 버전의 유저 고유 ID 로그인은 인증 수단 목록에 등록하지 않고 전환 기간에만 허용합니다
+			log.Warn().Msg("이전 버전의 유저 고유 ID 로그인을 허용합니다. 모든 유저가 인증 수단을 연결한 뒤 legacy를 제거하세요")
+			authService.EnableLegacyUidLogin()
+			continue
+		default:
+			err = fmt.Errorf("알 수 없는 인증 수단입니다: %v", name)
+		}
+
+		if err == nil {
+			err = authService.RegisterIdentityProvider(provider)
+		}
+		if err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	for _, c := range cfg.AuthIdTokenProviders {
+		verifier, err := identity.NewJwtVerifierFromFile(c.PublicKeyPath, c.Issuer, c.Audience)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("외부 ID 토큰 인증 수단 설정 오류 [%v]: %w", c.Name, err))
+			continue
+		}
+
+		provider, err := identity.NewIdTokenProvider(c.Name, verifier)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+
+		if err := authService.RegisterIdentityProvider(provider); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	return errs
+}
+
 func run(ctx context.Context, cfg *config.WebServerConfig) error {
 	opts := options.Client().ApplyURI(cfg.MongoUri)
 	mongoClient, err := mongo.Connect(ctx, opts)
@@ -255,8 +438,14 @@ func run(ctx context.Context, cfg *config.WebServerConfig) error {
 		panic(err)
 	}
 
+	// 데이터 테이블 검증 (잘못된 데이터로는 서버를 시작하지 않습니다)
+	if err := tableRepo.Validate(); err != nil {
+		log.Err(err).Msg("데이터 테이블 검증 오류")
+		panic(err)
+	}
+
 	// 서비스 등록
-	if err := setupServices(web_server, cfg, tableRepo); err != nil {
+	if err := setupServices(web_server, cfg, tableRepo, dataPath); err != nil {
 		log.Err(err).Msg("서비스 설정 오류")
 		panic(err)
 	}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"MScannot206/shared/table"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/rs/zerolog"
+)
+
+func main() {
+	var dataPath = flag.String("data", "data", "데이터 테이블(CSV) 디렉토리 경로 지정")
+	flag.Parse()
+
+	// 테이블 로드 로그는 검증 결과와 중복되므로 출력하지 않습니다
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+
+	tableRepo := &table.Repository{}
+	if err := tableRepo.Load(*dataPath); err != nil {
+		fmt.Fprintf(os.Stderr, "데이터 테이블 로드 오류 [path:%v]\n", *dataPath)
+		printErrors(err)
+		os.Exit(1)
+	}
+
+	if err := tableRepo.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "데이터 테이블 검증 오류 [path:%v]\n", *dataPath)
+		printErrors(err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("데이터 테이블 검증 완료 [path:%v]\n", *dataPath)
+}
+
+func printErrors(err error) {
+	var count int
+	for _, e := range unwrapJoined(err) {
+		fmt.Fprintf(os.Stderr, "  - %v\n", e)
+		count++
+	}
+	fmt.Fprintf(os.Stderr, "총 %d개의 오류가 있습니다\n", count)
+}
+
+func unwrapJoined(err error) []error {
+	var joined interface{ Unwrap() []error }
+	if !errors.As(err, &joined) {
+		return []error{err}
+	}
+
+	var ret []error
+	for _, e := range joined.Unwrap() {
+		ret = append(ret, unwrapJoined(e)...)
+	}
+	return ret
+}
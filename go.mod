@@ -2,13 +2,17 @@ module MScannot206
 
 go 1.25.4
 
-require go.mongodb.org/mongo-driver v1.17.6
+require (
+	github.com/rs/zerolog v1.34.0
+	go.mongodb.org/mongo-driver v1.17.6
+	golang.org/x/crypto v0.26.0
+	golang.org/x/text v0.17.0
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require (
-	github.com/google/uuid v1.6.0 // indirect
 	github.com/mattn/go-colorable v0.1.14 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
-	github.com/rs/zerolog v1.34.0 // indirect
 	golang.org/x/sys v0.39.0 // indirect
 )
 
@@ -20,8 +24,5 @@ require (
 	github.com/xdg-go/scram v1.1.2 // indirect
 	github.com/xdg-go/stringprep v1.0.4 // indirect
 	github.com/youmark/pkcs8 v0.0.0-20240726163527-a2c0da244d78 // indirect
-	golang.org/x/crypto v0.26.0 // indirect
 	golang.org/x/sync v0.8.0 // indirect
-	golang.org/x/text v0.17.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1
 )
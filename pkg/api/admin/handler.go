@@ -0,0 +1,107 @@
+package admin
+
+import (
+	"MScannot206/pkg/datatable"
+	"MScannot206/shared/service"
+	"MScannot206/shared/table"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+)
+
+func NewAdminHandler(
+	host service.ServiceHost,
+) (*AdminHandler, error) {
+	if host == nil {
+		return nil, service.ErrServiceHostIsNil
+	}
+
+	dataTableService, err := service.GetService[*datatable.DataTableService](host)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AdminHandler{
+		host:             host,
+		dataTableService: dataTableService,
+	}, nil
+}
+
+type AdminHandler struct {
+	host service.ServiceHost
+
+	dataTableService *datatable.DataTableService
+}
+
+func (h *AdminHandler) RegisterHandle(r *http.ServeMux) {
+	r.HandleFunc("POST /api/v1/admin/table/reload", h.onTableReload)
+}
+
+func (h *AdminHandler) GetApiNames() []string {
+	return []string{
+		"admin/table/reload",
+	}
+}
+
+func (h *AdminHandler) Execute(ctx context.Context, api string, body json.RawMessage) (any, error) {
+	switch api {
+	case "admin/table/reload":
+		return h.tableReload(ctx, body)
+
+	default:
+		return nil, errors.New("알 수 없는 API 호출입니다: " + api)
+	}
+}
+
+func (h *AdminHandler) tableReload(ctx context.Context, body json.RawMessage) (any, error) {
+	var req TableReloadRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	var res TableReloadResponse
+	if !h.dataTableService.IsValidAdminKey(req.AdminKey) {
+		res.ErrorCode = datatable.DATATABLE_ADMIN_KEY_INVALID_ERROR
+		return &res, nil
+	}
+
+	if err := h.dataTableService.Reload(ctx); err != nil {
+		res.ErrorCode = datatable.DATATABLE_RELOAD_ERROR
+		if errors.Is(err, table.ErrTableValidation) {
+			res.ErrorCode = datatable.DATATABLE_VALIDATION_ERROR
+		}
+	}
+
+	return &res, nil
+}
+
+// 데이터 테이블 리로드 핸들러
+func (h *AdminHandler) onTableReload(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.tableReload(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*TableReloadResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(&res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
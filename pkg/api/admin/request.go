@@ -0,0 +1,7 @@
+package admin
+
+// 데이터 테이블 리로드 요청
+type TableReloadRequest struct {
+	// 관리자 키
+	AdminKey string `json:"admin_key"`
+}
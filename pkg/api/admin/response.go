@@ -0,0 +1,7 @@
+package admin
+
+// 데이터 테이블 리로드 응답
+type TableReloadResponse struct {
+	// 리로드 오류 코드
+	ErrorCode string `json:"error_code,omitempty"`
+}
@@ -0,0 +1,384 @@
+package inventory
+
+import (
+	"MScannot206/pkg/auth"
+	"MScannot206/pkg/auth/session"
+	"MScannot206/pkg/inventory"
+	"MScannot206/shared/entity"
+	"MScannot206/shared/service"
+	"MScannot206/shared/types"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+)
+
+func NewInventoryHandler(
+	host service.ServiceHost,
+) (*InventoryHandler, error) {
+	if host == nil {
+		return nil, service.ErrServiceHostIsNil
+	}
+
+	authService, err := service.GetService[*auth.AuthService](host)
+	if err != nil {
+		return nil, err
+	}
+
+	inventoryService, err := service.GetService[*inventory.InventoryService](host)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InventoryHandler{
+		host:             host,
+		authService:      authService,
+		inventoryService: inventoryService,
+	}, nil
+}
+
+type InventoryHandler struct {
+	host service.ServiceHost
+
+	authService      *auth.AuthService
+	inventoryService *inventory.InventoryService
+}
+
+func (h *InventoryHandler) RegisterHandle(r *http.ServeMux) {
+	r.HandleFunc("POST /api/v1/inventory/list", h.onInventoryList)
+	r.HandleFunc("POST /api/v1/inventory/move", h.onInventoryMove)
+	r.HandleFunc("POST /api/v1/inventory/discard", h.onInventoryDiscard)
+}
+
+func (h *InventoryHandler) GetApiNames() []string {
+	return []string{
+		"inventory/list",
+		"inventory/move",
+		"inventory/discard",
+	}
+}
+
+func (h *InventoryHandler) Execute(ctx context.Context, api string, body json.RawMessage) (any, error) {
+	switch api {
+	case "inventory/list":
+		return h.inventoryList(ctx, body)
+
+	case "inventory/move":
+		return h.inventoryMove(ctx, body)
+
+	case "inventory/discard":
+		return h.inventoryDiscard(ctx, body)
+
+	default:
+		return nil, errors.New("알 수 없는 API 호출입니다: " + api)
+	}
+}
+
+func (h *InventoryHandler) inventoryList(ctx context.Context, body json.RawMessage) (any, error) {
+	var req InventoryListRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	requestCount := len(req.Requests)
+	sessions := make([]*entity.UserSession, 0, requestCount)
+	requests := make(map[string]*InventoryListInfo, requestCount)
+	var res InventoryListResponse
+
+	for _, entry := range req.Requests {
+		if inventory.IsInvalidInventorySlot(entry.Slot) {
+			res.Responses = append(res.Responses, &InventoryListResult{
+				Uid:       entry.Uid,
+				Slot:      entry.Slot,
+				ErrorCode: inventory.INVENTORY_SLOT_INVALID_ERROR,
+			})
+			continue
+		}
+
+		sessions = append(sessions, &entity.UserSession{
+			Uid:   entry.Uid,
+			Token: entry.Token,
+		})
+
+		requests[entry.Uid] = entry
+	}
+
+	_, invalidUids, err := h.authService.ValidateUserSessions(ctx, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, uid := range invalidUids {
+		res.Responses = append(res.Responses, &InventoryListResult{
+			Uid:       uid,
+			Slot:      requests[uid].Slot,
+			ErrorCode: session.SESSION_TOKEN_INVALID_ERROR,
+		})
+		delete(requests, uid)
+	}
+
+	items, failureUids, err := h.inventoryService.FindInventories(ctx, func() []*inventory.InventoryOwner {
+		owners := make([]*inventory.InventoryOwner, 0, len(requests))
+		for uid, info := range requests {
+			owners = append(owners, &inventory.InventoryOwner{
+				Uid:  uid,
+				Slot: info.Slot,
+			})
+		}
+		return owners
+	}())
+
+	if err != nil {
+		return nil, err
+	}
+
+	for uid, info := range requests {
+		if errCode, ok := failureUids[uid]; ok {
+			res.Responses = append(res.Responses, &InventoryListResult{
+				Uid:       uid,
+				Slot:      info.Slot,
+				ErrorCode: errCode,
+			})
+			continue
+		}
+
+		// 인벤토리 종류별로 분류
+		inventories := make(map[types.InventoryType][]*entity.InventoryItem)
+		for _, item := range items[uid] {
+			inventories[item.InventoryType] = append(inventories[item.InventoryType], item)
+		}
+
+		res.Responses = append(res.Responses, &InventoryListResult{
+			Uid:         uid,
+			Slot:        info.Slot,
+			Inventories: inventories,
+		})
+	}
+
+	return &res, nil
+}
+
+func (h *InventoryHandler) inventoryMove(ctx context.Context, body json.RawMessage) (any, error) {
+	var req InventoryMoveRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	requestCount := len(req.Requests)
+	sessions := make([]*entity.UserSession, 0, requestCount)
+	requests := make(map[string]*inventory.InventoryMove, requestCount)
+	var res InventoryMoveResponse
+
+	for _, entry := range req.Requests {
+		if inventory.IsInvalidInventorySlot(entry.FromSlot) || inventory.IsInvalidInventorySlot(entry.ToSlot) {
+			res.Responses = append(res.Responses, &InventoryMoveResult{
+				Uid:       entry.Uid,
+				ErrorCode: inventory.INVENTORY_SLOT_INVALID_ERROR,
+			})
+			continue
+		}
+
+		sessions = append(sessions, &entity.UserSession{
+			Uid:   entry.Uid,
+			Token: entry.Token,
+		})
+
+		requests[entry.Uid] = &inventory.InventoryMove{
+			Uid:      entry.Uid,
+			ItemId:   entry.ItemId,
+			FromSlot: entry.FromSlot,
+			ToSlot:   entry.ToSlot,
+			Count:    entry.Count,
+		}
+	}
+
+	_, invalidUids, err := h.authService.ValidateUserSessions(ctx, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, uid := range invalidUids {
+		delete(requests, uid)
+		res.Responses = append(res.Responses, &InventoryMoveResult{
+			Uid:       uid,
+			ErrorCode: session.SESSION_TOKEN_INVALID_ERROR,
+		})
+	}
+
+	failureUids, err := h.inventoryService.MoveItems(ctx, func() []*inventory.InventoryMove {
+		moves := make([]*inventory.InventoryMove, 0, len(requests))
+		for _, move := range requests {
+			moves = append(moves, move)
+		}
+		return moves
+	}())
+
+	if err != nil {
+		return nil, err
+	}
+
+	for uid := range requests {
+		res.Responses = append(res.Responses, &InventoryMoveResult{
+			Uid:       uid,
+			ErrorCode: failureUids[uid],
+		})
+	}
+
+	return &res, nil
+}
+
+func (h *InventoryHandler) inventoryDiscard(ctx context.Context, body json.RawMessage) (any, error) {
+	var req InventoryDiscardRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	requestCount := len(req.Requests)
+	sessions := make([]*entity.UserSession, 0, requestCount)
+	requests := make(map[string]*inventory.InventoryDiscard, requestCount)
+	var res InventoryDiscardResponse
+
+	for _, entry := range req.Requests {
+		if inventory.IsInvalidInventorySlot(entry.Slot) {
+			res.Responses = append(res.Responses, &InventoryDiscardResult{
+				Uid:       entry.Uid,
+				ErrorCode: inventory.INVENTORY_SLOT_INVALID_ERROR,
+			})
+			continue
+		}
+
+		sessions = append(sessions, &entity.UserSession{
+			Uid:   entry.Uid,
+			Token: entry.Token,
+		})
+
+		requests[entry.Uid] = &inventory.InventoryDiscard{
+			Uid:    entry.Uid,
+			ItemId: entry.ItemId,
+			Slot:   entry.Slot,
+			Count:  entry.Count,
+		}
+	}
+
+	_, invalidUids, err := h.authService.ValidateUserSessions(ctx, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, uid := range invalidUids {
+		delete(requests, uid)
+		res.Responses = append(res.Responses, &InventoryDiscardResult{
+			Uid:       uid,
+			ErrorCode: session.SESSION_TOKEN_INVALID_ERROR,
+		})
+	}
+
+	failureUids, err := h.inventoryService.DiscardItems(ctx, func() []*inventory.InventoryDiscard {
+		discards := make([]*inventory.InventoryDiscard, 0, len(requests))
+		for _, discard := range requests {
+			discards = append(discards, discard)
+		}
+		return discards
+	}())
+
+	if err != nil {
+		return nil, err
+	}
+
+	for uid := range requests {
+		res.Responses = append(res.Responses, &InventoryDiscardResult{
+			Uid:       uid,
+			ErrorCode: failureUids[uid],
+		})
+	}
+
+	return &res, nil
+}
+
+// 인벤토리 목록 핸들러
+func (h *InventoryHandler) onInventoryList(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.inventoryList(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*InventoryListResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(&res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// 아이템 이동 핸들러
+func (h *InventoryHandler) onInventoryMove(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.inventoryMove(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*InventoryMoveResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(&res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// 아이템 버리기 핸들러
+func (h *InventoryHandler) onInventoryDiscard(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.inventoryDiscard(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*InventoryDiscardResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(&res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,70 @@
+package inventory
+
+// 인벤토리 목록 요청 정보
+type InventoryListInfo struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 인증 토큰
+	Token string `json:"token"`
+
+	// 조회할 인벤토리 슬롯 (0: 계정 공용, 1 이상: 캐릭터 슬롯)
+	Slot int `json:"slot"`
+}
+
+// 인벤토리 목록 요청
+type InventoryListRequest struct {
+	// 목록 요청 목록
+	Requests []*InventoryListInfo `json:"requests"`
+}
+
+// 아이템 이동 요청 정보
+type InventoryMoveInfo struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 인증 토큰
+	Token string `json:"token"`
+
+	// 이동할 아이템 ID
+	ItemId string `json:"item_id"`
+
+	// 출발 인벤토리 슬롯
+	FromSlot int `json:"from_slot"`
+
+	// 도착 인벤토리 슬롯
+	ToSlot int `json:"to_slot"`
+
+	// 이동할 아이템 개수
+	Count int64 `json:"count"`
+}
+
+// 아이템 이동 요청
+type InventoryMoveRequest struct {
+	// 이동 요청 목록
+	Requests []*InventoryMoveInfo `json:"requests"`
+}
+
+// 아이템 버리기 요청 정보
+type InventoryDiscardInfo struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 인증 토큰
+	Token string `json:"token"`
+
+	// 버릴 아이템 ID
+	ItemId string `json:"item_id"`
+
+	// 아이템이 보관된 인벤토리 슬롯
+	Slot int `json:"slot"`
+
+	// 버릴 아이템 개수
+	Count int64 `json:"count"`
+}
+
+// 아이템 버리기 요청
+type InventoryDiscardRequest struct {
+	// 버리기 요청 목록
+	Requests []*InventoryDiscardInfo `json:"requests"`
+}
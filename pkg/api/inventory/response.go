@@ -0,0 +1,57 @@
+package inventory
+
+import (
+	"MScannot206/shared/entity"
+	"MScannot206/shared/types"
+)
+
+// 인벤토리 목록 결과
+type InventoryListResult struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 조회한 인벤토리 슬롯
+	Slot int `json:"slot"`
+
+	// 인벤토리 종류별 아이템 목록
+	Inventories map[types.InventoryType][]*entity.InventoryItem `json:"inventories,omitempty"`
+
+	// 조회 오류 코드
+	ErrorCode string `json:"error_code,omitempty"`
+}
+
+// 인벤토리 목록 응답
+type InventoryListResponse struct {
+	// 목록 결과 목록
+	Responses []*InventoryListResult `json:"responses"`
+}
+
+// 아이템 이동 결과
+type InventoryMoveResult struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 이동 오류 코드
+	ErrorCode string `json:"error_code,omitempty"`
+}
+
+// 아이템 이동 응답
+type InventoryMoveResponse struct {
+	// 이동 결과 목록
+	Responses []*InventoryMoveResult `json:"responses"`
+}
+
+// 아이템 버리기 결과
+type InventoryDiscardResult struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 버리기 오류 코드
+	ErrorCode string `json:"error_code,omitempty"`
+}
+
+// 아이템 버리기 응답
+type InventoryDiscardResponse struct {
+	// 버리기 결과 목록
+	Responses []*InventoryDiscardResult `json:"responses"`
+}
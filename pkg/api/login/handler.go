@@ -2,14 +2,18 @@ package login
 
 import (
 	"MScannot206/pkg/auth"
+	"MScannot206/pkg/auth/identity"
+	"MScannot206/pkg/auth/session"
 	"MScannot206/pkg/login"
 	"MScannot206/shared/entity"
 	"MScannot206/shared/service"
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
+	"slices"
 )
 
 func NewLoginHandler(
@@ -46,11 +50,15 @@ type LoginHandler struct {
 
 func (h *LoginHandler) RegisterHandle(r *http.ServeMux) {
 	r.HandleFunc("POST /api/v1/login", h.HandleLogin)
+	r.HandleFunc("POST /api/v1/auth/refresh", h.onRefresh)
+	r.HandleFunc("POST /api/v1/auth/link", h.onLink)
 }
 
 func (h *LoginHandler) GetApiNames() []string {
 	return []string{
 		"login",
+		"auth/refresh",
+		"auth/link",
 	}
 }
 
@@ -59,6 +67,12 @@ func (h *LoginHandler) Execute(ctx context.Context, api string, body json.RawMes
 	case "login":
 		return h.login(ctx, body)
 
+	case "auth/refresh":
+		return h.refresh(ctx, body)
+
+	case "auth/link":
+		return h.link(ctx, body)
+
 	default:
 		return nil, errors.New("알 수 없는 API 호출입니다: " + api)
 	}
@@ -70,15 +84,34 @@ func (h *LoginHandler) login(ctx context.Context, body json.RawMessage) (any, er
 		return nil, err
 	}
 
-	failureUids := make(map[string]struct{})
-	for _, uid := range req.Uids {
-		failureUids[uid] = struct{}{}
+	var res LoginResponse
+
+	creds := make([]*identity.Credential, 0, len(req.Credentials))
+	for _, c := range req.Credentials {
+		creds = append(creds, newCredential(c))
 	}
 
-	var res LoginResponse
+	// 인증 처리
+	results, err := h.authService.AuthenticateIdentities(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	uids := make([]string, 0, len(results))
+	uidSet := make(map[string]struct{}, len(results))
+	for _, result := range results {
+		if result.ErrorCode != "" {
+			continue
+		}
+		if _, ok := uidSet[result.Uid]; ok {
+			continue
+		}
+		uidSet[result.Uid] = struct{}{}
+		uids = append(uids, result.Uid)
+	}
 
 	// 로그인 처리
-	users, err := h.loginService.LoginUsers(ctx, req.Uids)
+	users, err := h.loginService.LoginUsers(ctx, uids)
 	if err != nil {
 		return nil, err
 	}
@@ -89,43 +122,182 @@ func (h *LoginHandler) login(ctx context.Context, body json.RawMessage) (any, er
 		return nil, err
 	}
 
-	// 로그인에 성공한 유저에 대한 처리 및 실패한 유저에 대한 처리
 	loggedinUsers := make(map[string]*entity.User, len(users))
 	for _, u := range users {
 		loggedinUsers[u.Uid] = u
-		delete(failureUids, u.Uid)
 	}
 
-	// 로그인에 실패한 유저의 경우 DB 쓰기 오류로 간주, 신규 유저는 새로 생성하기 때문
-	for uid := range failureUids {
-		reason := &LoginFailure{
-			Uid:       uid,
-			ErrorCode: login.LOGIN_DB_WRITE_ERROR,
+	failureSessions := make(map[string]struct{}, len(failureUsers))
+	for _, u := range failureUsers {
+		failureSessions[u.Uid] = struct{}{}
+	}
+
+	userSessions := make(map[string]*entity.UserSession, len(sessions))
+	for _, s := range sessions {
+		userSessions[s.Uid] = s
+	}
+
+	// 요청한 인증 정보 순서대로 결과 생성
+	for i, result := range results {
+		if result.ErrorCode != "" {
+			res.Failures = append(res.Failures, &LoginFailure{
+				Index:     i,
+				ErrorCode: result.ErrorCode,
+			})
+			continue
+		}
+
+		// 로그인에 실패한 유저의 경우 DB 쓰기 오류로 간주, 신규 유저는 새로 생성하기 때문
+		u, ok := loggedinUsers[result.Uid]
+		if !ok {
+			res.Failures = append(res.Failures, &LoginFailure{
+				Index:     i,
+				Uid:       result.Uid,
+				ErrorCode: login.LOGIN_DB_WRITE_ERROR,
+			})
+			continue
+		}
+
+		// 세션 생성에 실패한 유저
+		us, ok := userSessions[result.Uid]
+		if _, failed := failureSessions[result.Uid]; failed || !ok {
+			res.Failures = append(res.Failures, &LoginFailure{
+				Index:     i,
+				Uid:       result.Uid,
+				ErrorCode: login.LOGIN_SESSION_CREATE_ERROR,
+			})
+			continue
 		}
-		res.Failures = append(res.Failures, reason)
+
+		res.Successes = append(res.Successes, &LoginSuccess{
+			Index:         i,
+			UserEntity:    u,
+			Token:         us.Token,
+			TokenExpireAt: us.TokenExpireAt,
+			RefreshToken:  us.RefreshToken,
+		})
 	}
 
-	// 세션 생성에 실패한 유저 추가
-	for _, u := range failureUsers {
-		delete(failureUids, u.Uid)
+	return &res, nil
+}
+
+func newCredential(c *LoginCredential) *identity.Credential {
+	if c == nil {
+		c = &LoginCredential{}
+	}
+
+	return &identity.Credential{
+		Provider: c.Provider,
+		Username: c.Username,
+		Password: c.Password,
+		Register: c.Register,
+		DeviceId: c.DeviceId,
+		IdToken:  c.IdToken,
+		Uid:      c.Uid,
+	}
+}
+
+func (h *LoginHandler) refresh(ctx context.Context, body json.RawMessage) (any, error) {
+	var req RefreshRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*entity.UserSession, 0, len(req.Requests))
+	for _, entry := range req.Requests {
+		sessions = append(sessions, &entity.UserSession{
+			Uid:          entry.Uid,
+			RefreshToken: entry.RefreshToken,
+		})
+	}
+
+	refreshedSessions, failureUids, err := h.authService.RefreshUserSessions(ctx, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	var res RefreshResponse
+	for uid, errCode := range failureUids {
+		res.Responses = append(res.Responses, &RefreshResult{
+			Uid:       uid,
+			ErrorCode: errCode,
+		})
+	}
 
-		// 이유 추가
-		reason := &LoginFailure{
-			Uid:       u.Uid,
-			ErrorCode: login.LOGIN_SESSION_CREATE_ERROR,
+	for _, s := range refreshedSessions {
+		res.Responses = append(res.Responses, &RefreshResult{
+			Uid:           s.Uid,
+			Token:         s.Token,
+			TokenExpireAt: &s.TokenExpireAt,
+			RefreshToken:  s.RefreshToken,
+		})
+	}
+
+	return &res, nil
+}
+
+func (h *LoginHandler) link(ctx context.Context, body json.RawMessage) (any, error) {
+	var req LinkRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*entity.UserSession, 0, len(req.Requests))
+	for i, entry := range req.Requests {
+		if entry == nil {
+			entry = &LinkInfo{}
+			req.Requests[i] = entry
 		}
-		res.Failures = append(res.Failures, reason)
+
+		sessions = append(sessions, &entity.UserSession{
+			Uid:   entry.Uid,
+			Token: entry.Token,
+		})
 	}
 
-	// 최종적으로 성공한 유저 리스폰 정보 생성
-	for _, s := range sessions {
-		success := &LoginSuccess{
-			UserEntity: loggedinUsers[s.Uid],
-			Token:      s.Token,
+	validUids, _, err := h.authService.ValidateUserSessions(ctx, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	var res LinkResponse
+
+	indexes := make([]int, 0, len(req.Requests))
+	links := make([]*auth.IdentityLink, 0, len(req.Requests))
+	for i, entry := range req.Requests {
+		if !slices.Contains(validUids, entry.Uid) {
+			res.Responses = append(res.Responses, &LinkResult{
+				Index:     i,
+				Uid:       entry.Uid,
+				ErrorCode: session.SESSION_TOKEN_INVALID_ERROR,
+			})
+			continue
 		}
-		res.Successes = append(res.Successes, success)
+
+		indexes = append(indexes, i)
+		links = append(links, &auth.IdentityLink{
+			Uid:        entry.Uid,
+			Credential: newCredential(entry.Credential),
+		})
+	}
+
+	errCodes, err := h.authService.LinkIdentities(ctx, links)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, errCode := range errCodes {
+		res.Responses = append(res.Responses, &LinkResult{
+			Index:     indexes[i],
+			Uid:       links[i].Uid,
+			ErrorCode: errCode,
+		})
 	}
 
+	slices.SortFunc(res.Responses, func(a, b *LinkResult) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+
 	return &res, nil
 }
 
@@ -158,3 +330,61 @@ func (h *LoginHandler) HandleLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+// 세션 갱신 핸들러
+func (h *LoginHandler) onRefresh(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.refresh(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*RefreshResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// 인증 수단 연결 핸들러
+func (h *LoginHandler) onLink(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.link(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*LinkResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -1,7 +1,65 @@
 package login
 
+// 로그인 인증 정보
+// 인증 수단마다 사용하는 필드가 다릅니다
+type LoginCredential struct {
+	// 인증 수단 (password, device, legacy, 외부 ID 토큰 인증 수단 이름)
+	Provider string `json:"provider"`
+
+	// 아이디 (password)
+	Username string `json:"username,omitempty"`
+
+	// 비밀번호 (password)
+	Password string `json:"password,omitempty"`
+
+	// 계정이 없으면 새로 생성할지 여부 (password)
+	Register bool `json:"register,omitempty"`
+
+	// 기기 ID (device)
+	DeviceId string `json:"device_id,omitempty"`
+
+	// 외부 ID 토큰 (외부 ID 토큰 인증 수단)
+	IdToken string `json:"id_token,omitempty"`
+
+	// 이전 버전의 유저 고유 ID (legacy)
+	Uid string `json:"uid,omitempty"`
+}
+
 // 로그인 요청 구조체
 type LoginRequest struct {
-	// 로그인 요청할 유저의 UID 목록
-	Uids []string `json:"uids"`
+	// 로그인 요청할 인증 정보 목록
+	Credentials []*LoginCredential `json:"credentials"`
+}
+
+// 세션 갱신 요청 정보
+type RefreshInfo struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 리프레시 토큰
+	RefreshToken string `json:"refresh_token"`
+}
+
+// 세션 갱신 요청
+type RefreshRequest struct {
+	// 갱신 요청 목록
+	Requests []*RefreshInfo `json:"requests"`
+}
+
+// 인증 수단 연결 요청 정보
+type LinkInfo struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 인증 토큰
+	Token string `json:"token"`
+
+	// 연결할 인증 정보
+	Credential *LoginCredential `json:"credential"`
+}
+
+// 인증 수단 연결 요청
+type LinkRequest struct {
+	// 연결 요청 목록
+	Requests []*LinkInfo `json:"requests"`
 }
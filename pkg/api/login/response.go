@@ -1,14 +1,32 @@
 package login
 
-import "MScannot206/shared/entity"
+import (
+	"MScannot206/shared/entity"
+	"time"
+)
 
 type LoginSuccess struct {
+	// 요청한 인증 정보 목록의 인덱스
+	Index int `json:"index"`
+
 	UserEntity *entity.User `json:"user_entity"`
-	Token      string       `json:"token"`
+
+	// 액세스 토큰
+	Token string `json:"token"`
+
+	// 액세스 토큰 만료 시각
+	TokenExpireAt time.Time `json:"token_expire_at"`
+
+	// 리프레시 토큰
+	RefreshToken string `json:"refresh_token"`
 }
 
 type LoginFailure struct {
-	Uid       string `json:"uid"`
+	// 요청한 인증 정보 목록의 인덱스
+	Index int `json:"index"`
+
+	// 인증에 성공한 뒤 실패한 경우의 유저 고유 ID
+	Uid       string `json:"uid,omitempty"`
 	ErrorCode string `json:"error_code,omitempty"`
 }
 
@@ -17,3 +35,41 @@ type LoginResponse struct {
 	Successes []*LoginSuccess `json:"successes"`
 	Failures  []*LoginFailure `json:"failures"`
 }
+
+// 세션 갱신 결과
+type RefreshResult struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 새 액세스 토큰
+	Token string `json:"token,omitempty"`
+
+	// 새 액세스 토큰 만료 시각
+	TokenExpireAt *time.Time `json:"token_expire_at,omitempty"`
+
+	// 새 리프레시 토큰, 사용한 리프레시 토큰은 더 이상 사용할 수 없습니다
+	RefreshToken string `json:"refresh_token,omitempty"`
+
+	ErrorCode string `json:"error_code,omitempty"`
+}
+
+// 세션 갱신 응답
+type RefreshResponse struct {
+	Responses []*RefreshResult `json:"responses"`
+}
+
+// 인증 수단 연결 결과
+type LinkResult struct {
+	// 요청한 연결 요청 목록의 인덱스
+	Index int `json:"index"`
+
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	ErrorCode string `json:"error_code,omitempty"`
+}
+
+// 인증 수단 연결 응답
+type LinkResponse struct {
+	Responses []*LinkResult `json:"responses"`
+}
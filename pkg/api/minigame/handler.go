@@ -0,0 +1,240 @@
+package minigame
+
+import (
+	"MScannot206/pkg/auth"
+	"MScannot206/pkg/auth/session"
+	"MScannot206/pkg/minigame/clicker"
+	"MScannot206/shared/entity"
+	"MScannot206/shared/service"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+)
+
+func NewMinigameHandler(
+	host service.ServiceHost,
+) (*MinigameHandler, error) {
+	if host == nil {
+		return nil, service.ErrServiceHostIsNil
+	}
+
+	authService, err := service.GetService[*auth.AuthService](host)
+	if err != nil {
+		return nil, err
+	}
+
+	clickerService, err := service.GetService[*clicker.ClickerService](host)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MinigameHandler{
+		host:           host,
+		authService:    authService,
+		clickerService: clickerService,
+	}, nil
+}
+
+type MinigameHandler struct {
+	host service.ServiceHost
+
+	authService    *auth.AuthService
+	clickerService *clicker.ClickerService
+}
+
+func (h *MinigameHandler) RegisterHandle(r *http.ServeMux) {
+	r.HandleFunc("POST /api/v1/minigame/clicker/start", h.onClickerStart)
+	r.HandleFunc("POST /api/v1/minigame/clicker/click", h.onClickerClick)
+}
+
+func (h *MinigameHandler) GetApiNames() []string {
+	return []string{
+		"minigame/clicker/start",
+		"minigame/clicker/click",
+	}
+}
+
+func (h *MinigameHandler) Execute(ctx context.Context, api string, body json.RawMessage) (any, error) {
+	switch api {
+	case "minigame/clicker/start":
+		return h.clickerStart(ctx, body)
+
+	case "minigame/clicker/click":
+		return h.clickerClick(ctx, body)
+
+	default:
+		return nil, errors.New("알 수 없는 API 호출입니다: " + api)
+	}
+}
+
+func (h *MinigameHandler) clickerStart(ctx context.Context, body json.RawMessage) (any, error) {
+	var req ClickerStartRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	requestCount := len(req.Requests)
+	sessions := make([]*entity.UserSession, 0, requestCount)
+	for _, entry := range req.Requests {
+		sessions = append(sessions, &entity.UserSession{
+			Uid:   entry.Uid,
+			Token: entry.Token,
+		})
+	}
+
+	validUids, invalidUids, err := h.authService.ValidateUserSessions(ctx, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ClickerStartResponse
+	for _, uid := range invalidUids {
+		res.Responses = append(res.Responses, &ClickerStartResult{
+			Uid:       uid,
+			ErrorCode: session.SESSION_TOKEN_INVALID_ERROR,
+		})
+	}
+
+	monsters, failureUids, err := h.clickerService.StartGames(ctx, validUids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, uid := range validUids {
+		res.Responses = append(res.Responses, &ClickerStartResult{
+			Uid:       uid,
+			Monster:   newClickerMonsterInfo(monsters[uid]),
+			ErrorCode: failureUids[uid],
+		})
+	}
+
+	return &res, nil
+}
+
+func (h *MinigameHandler) clickerClick(ctx context.Context, body json.RawMessage) (any, error) {
+	var req ClickerClickRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	requestCount := len(req.Requests)
+	sessions := make([]*entity.UserSession, 0, requestCount)
+	requests := make(map[string]*clicker.ClickerClick, requestCount)
+	for _, entry := range req.Requests {
+		sessions = append(sessions, &entity.UserSession{
+			Uid:   entry.Uid,
+			Token: entry.Token,
+		})
+
+		requests[entry.Uid] = &clicker.ClickerClick{
+			Uid:    entry.Uid,
+			Clicks: entry.Clicks,
+		}
+	}
+
+	_, invalidUids, err := h.authService.ValidateUserSessions(ctx, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	var res ClickerClickResponse
+	for _, uid := range invalidUids {
+		delete(requests, uid)
+		res.Responses = append(res.Responses, &ClickerClickResult{
+			Uid:       uid,
+			ErrorCode: session.SESSION_TOKEN_INVALID_ERROR,
+		})
+	}
+
+	results, err := h.clickerService.Click(ctx, func() []*clicker.ClickerClick {
+		clicks := make([]*clicker.ClickerClick, 0, len(requests))
+		for _, click := range requests {
+			clicks = append(clicks, click)
+		}
+		return clicks
+	}())
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		clickResult := &ClickerClickResult{
+			Uid:       result.Uid,
+			Monster:   newClickerMonsterInfo(result.Monster),
+			Killed:    newClickerMonsterInfo(result.Killed),
+			ErrorCode: result.ErrorCode,
+		}
+
+		if result.Reward != nil {
+			clickResult.Reward = &ClickerRewardInfo{
+				Index: result.Reward.Index,
+				Count: result.Reward.Count,
+			}
+		}
+
+		res.Responses = append(res.Responses, clickResult)
+	}
+
+	return &res, nil
+}
+
+// 클리커 시작 핸들러
+func (h *MinigameHandler) onClickerStart(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.clickerStart(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*ClickerStartResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(&res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// 클리커 클릭 핸들러
+func (h *MinigameHandler) onClickerClick(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.clickerClick(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*ClickerClickResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(&res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
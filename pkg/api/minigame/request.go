@@ -0,0 +1,34 @@
+package minigame
+
+// 클리커 시작 요청 정보
+type ClickerStartInfo struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 인증 토큰
+	Token string `json:"token"`
+}
+
+// 클리커 시작 요청
+type ClickerStartRequest struct {
+	// 시작 요청 목록
+	Requests []*ClickerStartInfo `json:"requests"`
+}
+
+// 클리커 클릭 요청 정보
+type ClickerClickInfo struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 인증 토큰
+	Token string `json:"token"`
+
+	// 마지막 요청 이후 클릭 횟수
+	Clicks int64 `json:"clicks"`
+}
+
+// 클리커 클릭 요청
+type ClickerClickRequest struct {
+	// 클릭 요청 목록
+	Requests []*ClickerClickInfo `json:"requests"`
+}
@@ -0,0 +1,82 @@
+package minigame
+
+import "MScannot206/pkg/minigame/clicker"
+
+// 클리커 몬스터 정보
+type ClickerMonsterInfo struct {
+	// 몬스터 테이블 인덱스
+	Index string `json:"index"`
+
+	// 몬스터 모델 ID
+	ModelID string `json:"model_id"`
+
+	// 남은 체력
+	Hp int64 `json:"hp"`
+
+	// 최대 체력
+	MaxHp int64 `json:"max_hp"`
+}
+
+func newClickerMonsterInfo(monster *clicker.ClickerMonster) *ClickerMonsterInfo {
+	if monster == nil {
+		return nil
+	}
+
+	return &ClickerMonsterInfo{
+		Index:   monster.Index,
+		ModelID: monster.ModelID,
+		Hp:      monster.Hp,
+		MaxHp:   monster.MaxHp,
+	}
+}
+
+// 클리커 보상 정보
+type ClickerRewardInfo struct {
+	// 지급된 아이템 테이블 인덱스
+	Index string `json:"index"`
+
+	// 지급된 아이템 개수
+	Count int64 `json:"count"`
+}
+
+// 클리커 시작 결과
+type ClickerStartResult struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 진행 중인 몬스터
+	Monster *ClickerMonsterInfo `json:"monster,omitempty"`
+
+	// 시작 오류 코드
+	ErrorCode string `json:"error_code,omitempty"`
+}
+
+// 클리커 시작 응답
+type ClickerStartResponse struct {
+	// 시작 결과 목록
+	Responses []*ClickerStartResult `json:"responses"`
+}
+
+// 클리커 클릭 결과
+type ClickerClickResult struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 클릭을 적용한 뒤의 몬스터 (처치 시 새로 생성된 몬스터)
+	Monster *ClickerMonsterInfo `json:"monster,omitempty"`
+
+	// 처치한 몬스터
+	Killed *ClickerMonsterInfo `json:"killed,omitempty"`
+
+	// 처치 보상
+	Reward *ClickerRewardInfo `json:"reward,omitempty"`
+
+	// 클릭 오류 코드
+	ErrorCode string `json:"error_code,omitempty"`
+}
+
+// 클리커 클릭 응답
+type ClickerClickResponse struct {
+	// 클릭 결과 목록
+	Responses []*ClickerClickResult `json:"responses"`
+}
@@ -1,9 +1,12 @@
 package api
 
 import (
+	"MScannot206/pkg/api/admin"
 	"MScannot206/pkg/api/batch"
 	channel_api "MScannot206/pkg/api/channel"
+	"MScannot206/pkg/api/inventory"
 	"MScannot206/pkg/api/login"
+	"MScannot206/pkg/api/minigame"
 	"MScannot206/pkg/api/user"
 	"MScannot206/shared/service"
 	"context"
@@ -52,6 +55,21 @@ func SetupRoutes(host service.ServiceHost, r *http.ServeMux) error {
 		errs = errors.Join(errs, err)
 	}
 
+	inventoryHandler, err := inventory.NewInventoryHandler(host)
+	if err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	minigameHandler, err := minigame.NewMinigameHandler(host)
+	if err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	adminHandler, err := admin.NewAdminHandler(host)
+	if err != nil {
+		errs = errors.Join(errs, err)
+	}
+
 	if errs != nil {
 		return errs
 	}
@@ -64,6 +82,9 @@ func SetupRoutes(host service.ServiceHost, r *http.ServeMux) error {
 		loginHandler,
 		userHandler,
 		channelHandler,
+		inventoryHandler,
+		minigameHandler,
+		adminHandler,
 	} {
 		// 핸들러 등록
 		h.RegisterHandle(r)
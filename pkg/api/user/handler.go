@@ -4,13 +4,16 @@ import (
 	"MScannot206/pkg/auth"
 	"MScannot206/pkg/auth/session"
 	"MScannot206/pkg/user"
+	"MScannot206/shared/def"
 	"MScannot206/shared/entity"
 	"MScannot206/shared/service"
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
+	"slices"
 )
 
 func NewUserHandler(
@@ -47,14 +50,30 @@ type UserHandler struct {
 func (h *UserHandler) RegisterHandle(r *http.ServeMux) {
 	r.HandleFunc("POST /api/v1/user/character/create", h.onCreateCharacter)
 	r.HandleFunc("POST /api/v1/user/character/create/check_name", h.onCheckCharacterName)
+	r.HandleFunc("POST /api/v1/user/character/create/preview", h.onPreviewCharacter)
 	r.HandleFunc("POST /api/v1/user/character/delete", h.onDeleteCharacter)
+	r.HandleFunc("POST /api/v1/user/character/restore", h.onRestoreCharacter)
+	r.HandleFunc("POST /api/v1/user/character/rename", h.onRenameCharacter)
+	r.HandleFunc("POST /api/v1/user/character/list", h.onCharacterList)
+	r.HandleFunc("POST /api/v1/user/character/get", h.onGetCharacter)
+	r.HandleFunc("POST /api/v1/user/character/equip", h.onEquipCharacter)
+	r.HandleFunc("POST /api/v1/user/character/unequip", h.onUnequipCharacter)
+	r.HandleFunc("POST /api/v1/user/character/slot/expand", h.onExpandCharacterSlot)
 }
 
 func (h *UserHandler) GetApiNames() []string {
 	return []string{
 		"user/character/create",
 		"user/character/create/check_name",
+		"user/character/create/preview",
 		"user/character/delete",
+		"user/character/restore",
+		"user/character/rename",
+		"user/character/list",
+		"user/character/get",
+		"user/character/equip",
+		"user/character/unequip",
+		"user/character/slot/expand",
 	}
 }
 
@@ -66,9 +85,33 @@ func (h *UserHandler) Execute(ctx context.Context, api string, body json.RawMess
 	case "user/character/create/check_name":
 		return h.checkCharacterName(ctx, body)
 
+	case "user/character/create/preview":
+		return h.previewCharacter(ctx, body)
+
 	case "user/character/delete":
 		return h.deleteCharacter(ctx, body)
 
+	case "user/character/restore":
+		return h.restoreCharacter(ctx, body)
+
+	case "user/character/rename":
+		return h.renameCharacter(ctx, body)
+
+	case "user/character/list":
+		return h.characterList(ctx, body)
+
+	case "user/character/get":
+		return h.getCharacter(ctx, body)
+
+	case "user/character/equip":
+		return h.equipCharacter(ctx, body)
+
+	case "user/character/unequip":
+		return h.unequipCharacter(ctx, body)
+
+	case "user/character/slot/expand":
+		return h.expandCharacterSlot(ctx, body)
+
 	default:
 		return nil, errors.New("알 수 없는 API 호출입니다: " + api)
 	}
@@ -89,11 +132,11 @@ func (h *UserHandler) createCharacter(ctx context.Context, body json.RawMessage)
 		errCode := ""
 
 		// 캐릭터 슬롯 유효성 검사
-		if user.IsInvalidCharacterSlot(entry.Slot) {
+		if user.IsInvalidCharacterSlot(entry.Slot, def.MaxCharacterSlot) {
 			errCode = user.USER_CHARACTER_SLOT_INVALID_ERROR
 		} else {
 			// 캐릭터 이름 유효성 검사
-			errCode = user.ValidateCharacterName(entry.Name, h.host.GetLocale())
+			errCode = h.userService.ValidateCharacterName(entry.Name, h.host.GetLocale())
 		}
 
 		// 오류가 있을 경우 다음 요청으로 넘어감
@@ -111,10 +154,11 @@ func (h *UserHandler) createCharacter(ctx context.Context, body json.RawMessage)
 		})
 
 		requests[entry.Uid] = &user.UserCreateCharacter{
-			Uid:    entry.Uid,
-			Slot:   entry.Slot,
-			Name:   entry.Name,
-			Gender: entry.Gender,
+			Uid:        entry.Uid,
+			Slot:       entry.Slot,
+			Name:       entry.Name,
+			Gender:     entry.Gender,
+			Appearance: entry.Appearance,
 		}
 	}
 
@@ -131,6 +175,31 @@ func (h *UserHandler) createCharacter(ctx context.Context, body json.RawMessage)
 		})
 	}
 
+	// 유저별 캐릭터 슬롯 수 검사
+	slotLimits, err := h.userService.FindCharacterSlotLimits(ctx, func() []string {
+		uids := make([]string, 0, len(requests))
+		for uid := range requests {
+			uids = append(uids, uid)
+		}
+		return uids
+	}())
+
+	if err != nil {
+		return nil, err
+	}
+
+	for uid, info := range requests {
+		if !user.IsInvalidCharacterSlot(info.Slot, slotLimits[uid]) {
+			continue
+		}
+
+		res.Responses = append(res.Responses, &UserCreateCharacterResult{
+			Uid:       uid,
+			ErrorCode: user.USER_CHARACTER_SLOT_INVALID_ERROR,
+		})
+		delete(requests, uid)
+	}
+
 	userCharacters, err := h.userService.FindCharactersByUids(ctx, func() []string {
 		uids := make([]string, 0, len(requests))
 		for uid := range requests {
@@ -191,16 +260,7 @@ func (h *UserHandler) createCharacter(ctx context.Context, body json.RawMessage)
 		res.Responses = append(res.Responses, &UserCreateCharacterResult{
 			Uid:       uid,
 			Character: result.Character,
-			Equips: func() []*entity.CharacterEquip {
-				equips := make([]*entity.CharacterEquip, 0, len(result.Equips))
-				for equipType, index := range result.Equips {
-					equips = append(equips, &entity.CharacterEquip{
-						Type:  equipType,
-						Index: index,
-					})
-				}
-				return equips
-			}(),
+			Equips:    entity.NewCharacterEquips(result.Equips),
 		})
 	}
 
@@ -215,12 +275,12 @@ func (h *UserHandler) checkCharacterName(ctx context.Context, body json.RawMessa
 
 	requestCount := len(req.Requests)
 	sessions := make([]*entity.UserSession, 0, requestCount)
-	requests := make(map[string]*user.UserCreateCharacter, requestCount)
+	requests := make(map[string]*user.UserCheckCharacterName, requestCount)
 	var res CheckCharacterNameResponse
 
 	for _, entry := range req.Requests {
 		// 캐릭터 이름 유효성 검사
-		if errCode := user.ValidateCharacterName(entry.Name, h.host.GetLocale()); errCode != "" {
+		if errCode := h.userService.ValidateCharacterName(entry.Name, h.host.GetLocale()); errCode != "" {
 			res.Responses = append(res.Responses, &UserNameCheckResult{
 				Uid:       entry.Uid,
 				ErrorCode: errCode,
@@ -233,9 +293,10 @@ func (h *UserHandler) checkCharacterName(ctx context.Context, body json.RawMessa
 			Token: entry.Token,
 		})
 
-		requests[entry.Uid] = &user.UserCreateCharacter{
-			Uid:  entry.Uid,
-			Name: entry.Name,
+		requests[entry.Uid] = &user.UserCheckCharacterName{
+			Uid:     entry.Uid,
+			Name:    entry.Name,
+			Reserve: entry.Reserve,
 		}
 	}
 
@@ -252,29 +313,174 @@ func (h *UserHandler) checkCharacterName(ctx context.Context, body json.RawMessa
 		})
 	}
 
-	existingNames, err := h.userService.FindCharacterNames(ctx, func() []string {
-		names := make([]string, 0, len(requests))
+	checkResult, err := h.userService.CheckCharacterNames(ctx, func() []*user.UserCheckCharacterName {
+		checkInfos := make([]*user.UserCheckCharacterName, 0, len(requests))
 		for _, info := range requests {
-			names = append(names, info.Name)
+			checkInfos = append(checkInfos, info)
 		}
-		return names
+		return checkInfos
 	}())
 
 	if err != nil {
 		return nil, err
 	}
 
-	for uid, info := range requests {
-		if exists, ok := existingNames[info.Name]; ok && exists {
+	for uid := range requests {
+		result, ok := checkResult[uid]
+		if !ok {
 			res.Responses = append(res.Responses, &UserNameCheckResult{
 				Uid:       uid,
-				ErrorCode: user.USER_CHARACTER_NAME_ALREADY_EXISTS_ERROR,
+				ErrorCode: user.USER_CHECK_CHARACTER_NAME_UNKNOWN_ERROR,
 			})
-		} else {
-			res.Responses = append(res.Responses, &UserNameCheckResult{
-				Uid: uid,
+			continue
+		}
+
+		response := &UserNameCheckResult{
+			Uid:       uid,
+			ErrorCode: result.ErrorCode,
+		}
+		if !result.ReservedUntil.IsZero() {
+			response.ReservedUntil = &result.ReservedUntil
+		}
+		res.Responses = append(res.Responses, response)
+	}
+
+	return &res, nil
+}
+
+func (h *UserHandler) previewCharacter(ctx context.Context, body json.RawMessage) (any, error) {
+	var req PreviewCharacterRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	requestCount := len(req.Requests)
+	sessions := make([]*entity.UserSession, 0, requestCount)
+	requests := make(map[string]*user.UserPreviewCharacter, requestCount)
+	var res PreviewCharacterResponse
+
+	for _, entry := range req.Requests {
+		// 캐릭터 슬롯 유효성 검사
+		if user.IsInvalidCharacterSlot(entry.Slot, def.MaxCharacterSlot) {
+			res.Responses = append(res.Responses, &UserPreviewCharacterResult{
+				Uid:       entry.Uid,
+				ErrorCode: user.USER_CHARACTER_SLOT_INVALID_ERROR,
+			})
+			continue
+		}
+
+		sessions = append(sessions, &entity.UserSession{
+			Uid:   entry.Uid,
+			Token: entry.Token,
+		})
+
+		requests[entry.Uid] = &user.UserPreviewCharacter{
+			Uid:        entry.Uid,
+			Slot:       entry.Slot,
+			Gender:     entry.Gender,
+			Appearance: entry.Appearance,
+		}
+	}
+
+	_, invalidUids, err := h.authService.ValidateUserSessions(ctx, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, uid := range invalidUids {
+		delete(requests, uid)
+		res.Responses = append(res.Responses, &UserPreviewCharacterResult{
+			Uid:       uid,
+			ErrorCode: session.SESSION_TOKEN_INVALID_ERROR,
+		})
+	}
+
+	// 유저별 캐릭터 슬롯 수 검사
+	slotLimits, err := h.userService.FindCharacterSlotLimits(ctx, func() []string {
+		uids := make([]string, 0, len(requests))
+		for uid := range requests {
+			uids = append(uids, uid)
+		}
+		return uids
+	}())
+
+	if err != nil {
+		return nil, err
+	}
+
+	for uid, info := range requests {
+		if !user.IsInvalidCharacterSlot(info.Slot, slotLimits[uid]) {
+			continue
+		}
+
+		res.Responses = append(res.Responses, &UserPreviewCharacterResult{
+			Uid:       uid,
+			ErrorCode: user.USER_CHARACTER_SLOT_INVALID_ERROR,
+		})
+		delete(requests, uid)
+	}
+
+	userCharacters, err := h.userService.FindCharactersByUids(ctx, func() []string {
+		uids := make([]string, 0, len(requests))
+		for uid := range requests {
+			uids = append(uids, uid)
+		}
+		return uids
+	}())
+
+	if err != nil {
+		return nil, err
+	}
+
+	for uid, characters := range userCharacters {
+		for _, ch := range characters {
+			if ch.Slot != requests[uid].Slot {
+				continue
+			}
+
+			res.Responses = append(res.Responses, &UserPreviewCharacterResult{
+				Uid:       uid,
+				ErrorCode: user.USER_CHARACTER_SLOT_ALREADY_EXISTS_ERROR,
+			})
+			delete(requests, uid)
+			break
+		}
+	}
+
+	previewResult, err := h.userService.PreviewCharacterByUsers(ctx, func() []*user.UserPreviewCharacter {
+		previewInfos := make([]*user.UserPreviewCharacter, 0, len(requests))
+		for _, info := range requests {
+			previewInfos = append(previewInfos, info)
+		}
+		return previewInfos
+	}())
+
+	if err != nil {
+		return nil, err
+	}
+
+	for uid := range requests {
+		result, ok := previewResult[uid]
+		if !ok {
+			res.Responses = append(res.Responses, &UserPreviewCharacterResult{
+				Uid:       uid,
+				ErrorCode: user.USER_CREATE_CHARACTER_UNKNOWN_ERROR,
 			})
+			continue
+		}
+
+		response := &UserPreviewCharacterResult{
+			Uid:         uid,
+			RerollsLeft: result.RerollsLeft,
+			ErrorCode:   result.ErrorCode,
 		}
+		if len(result.Equips) > 0 {
+			response.Equips = entity.NewCharacterEquips(result.Equips)
+		}
+		if !result.ExpireAt.IsZero() {
+			response.ExpireAt = &result.ExpireAt
+		}
+		res.Responses = append(res.Responses, response)
 	}
 
 	return &res, nil
@@ -293,7 +499,7 @@ func (h *UserHandler) deleteCharacter(ctx context.Context, body json.RawMessage)
 	var res DeleteCharacterResponse
 
 	for _, entry := range req.Requests {
-		if user.IsInvalidCharacterSlot(entry.Slot) {
+		if user.IsInvalidCharacterSlot(entry.Slot, def.MaxCharacterSlot) {
 			res.Responses = append(res.Responses, &UserDeleteCharacterResult{
 				Uid:       entry.Uid,
 				ErrorCode: user.USER_CHARACTER_SLOT_INVALID_ERROR,
@@ -326,6 +532,31 @@ func (h *UserHandler) deleteCharacter(ctx context.Context, body json.RawMessage)
 		})
 	}
 
+	// 유저별 캐릭터 슬롯 수 검사
+	slotLimits, err := h.userService.FindCharacterSlotLimits(ctx, func() []string {
+		uids := make([]string, 0, len(requests))
+		for uid := range requests {
+			uids = append(uids, uid)
+		}
+		return uids
+	}())
+
+	if err != nil {
+		return nil, err
+	}
+
+	for uid, info := range requests {
+		if !user.IsInvalidCharacterSlot(info.Slot, slotLimits[uid]) {
+			continue
+		}
+
+		res.Responses = append(res.Responses, &UserDeleteCharacterResult{
+			Uid:       uid,
+			ErrorCode: user.USER_CHARACTER_SLOT_INVALID_ERROR,
+		})
+		delete(requests, uid)
+	}
+
 	userCharacters, err := h.userService.FindCharactersByUids(ctx, func() []string {
 		uids := make([]string, 0, len(requests))
 		for uid := range requests {
@@ -366,90 +597,742 @@ func (h *UserHandler) deleteCharacter(ctx context.Context, body json.RawMessage)
 	}
 
 	// 캐릭터 삭제 처리
-	successUids, err := h.userService.DeleteCharactersByUsers(ctx, userDeleteCharacters)
+	deleteResults, err := h.userService.DeleteCharactersByUsers(ctx, userDeleteCharacters)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, uid := range successUids {
-		if _, ok := requests[uid]; ok {
-			res.Responses = append(res.Responses, &UserDeleteCharacterResult{
-				Uid:  uid,
-				Slot: requests[uid].Slot,
-			})
-		} else {
+	for _, info := range userDeleteCharacters {
+		result, ok := deleteResults[info.Uid]
+		if !ok {
+			result.ErrorCode = user.USER_DELETE_CHARACTER_DB_WRITE_ERROR
+		}
+
+		if result.ErrorCode != "" {
 			res.Responses = append(res.Responses, &UserDeleteCharacterResult{
-				Uid:       uid,
-				ErrorCode: user.USER_DELETE_CHARACTER_DB_WRITE_ERROR,
+				Uid:       info.Uid,
+				ErrorCode: result.ErrorCode,
 			})
+			continue
 		}
+
+		res.Responses = append(res.Responses, &UserDeleteCharacterResult{
+			Uid:     info.Uid,
+			Slot:    info.Slot,
+			PurgeAt: &result.PurgeAt,
+		})
 	}
 
 	return &res, nil
 }
 
-// 캐릭터 생성 핸들러
-func (h *UserHandler) onCreateCharacter(w http.ResponseWriter, r *http.Request) {
-	body, err := io.ReadAll(r.Body)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+func (h *UserHandler) restoreCharacter(ctx context.Context, body json.RawMessage) (any, error) {
+	var req RestoreCharacterRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
 	}
-	defer r.Body.Close()
 
-	ret, err := h.createCharacter(r.Context(), body)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
+	requestCount := len(req.Requests)
+	sessions := make([]*entity.UserSession, 0, requestCount)
+	requests := make(map[string]*user.UserRestoreCharacter, requestCount)
 
-	res, ok := ret.(*CreateCharacterResponse)
-	if !ok {
-		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
-		return
-	}
+	var res RestoreCharacterResponse
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
+	for _, entry := range req.Requests {
+		if user.IsInvalidCharacterSlot(entry.Slot, def.MaxCharacterSlot) {
+			res.Responses = append(res.Responses, &UserRestoreCharacterResult{
+				Uid:       entry.Uid,
+				ErrorCode: user.USER_RESTORE_CHARACTER_SLOT_INVALID_ERROR,
+			})
+			continue
+		}
 
-	if err := json.NewEncoder(w).Encode(&res); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-	}
-}
+		sessions = append(sessions, &entity.UserSession{
+			Uid:   entry.Uid,
+			Token: entry.Token,
+		})
 
-// 캐릭터 이름 중복 확인 핸들러
-func (h *UserHandler) onCheckCharacterName(w http.ResponseWriter, r *http.Request) {
-	body, err := io.ReadAll(r.Body)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+		requests[entry.Uid] = &user.UserRestoreCharacter{
+			Uid:  entry.Uid,
+			Slot: entry.Slot,
+		}
 	}
-	defer r.Body.Close()
 
-	ret, err := h.checkCharacterName(r.Context(), body)
+	_, invalidUids, err := h.authService.ValidateUserSessions(ctx, sessions)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+		return nil, err
 	}
 
-	res, ok := ret.(*CheckCharacterNameResponse)
-	if !ok {
-		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
-		return
+	for _, uid := range invalidUids {
+		delete(requests, uid)
+		res.Responses = append(res.Responses, &UserRestoreCharacterResult{
+			Uid:       uid,
+			ErrorCode: session.SESSION_TOKEN_INVALID_ERROR,
+		})
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(&res); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-	}
-}
+	// 유저별 캐릭터 슬롯 수 검사
+	slotLimits, err := h.userService.FindCharacterSlotLimits(ctx, func() []string {
+		uids := make([]string, 0, len(requests))
+		for uid := range requests {
+			uids = append(uids, uid)
+		}
+		return uids
+	}())
 
-// 캐릭터 삭제 핸들러
-func (h *UserHandler) onDeleteCharacter(w http.ResponseWriter, r *http.Request) {
-	body, err := io.ReadAll(r.Body)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, err
+	}
+
+	for uid, info := range requests {
+		if !user.IsInvalidCharacterSlot(info.Slot, slotLimits[uid]) {
+			continue
+		}
+
+		res.Responses = append(res.Responses, &UserRestoreCharacterResult{
+			Uid:       uid,
+			ErrorCode: user.USER_RESTORE_CHARACTER_SLOT_INVALID_ERROR,
+		})
+		delete(requests, uid)
+	}
+
+	restoreResults, err := h.userService.RestoreCharactersByUsers(ctx, func() []*user.UserRestoreCharacter {
+		restoreInfos := make([]*user.UserRestoreCharacter, 0, len(requests))
+		for _, info := range requests {
+			restoreInfos = append(restoreInfos, info)
+		}
+		return restoreInfos
+	}())
+	if err != nil {
+		return nil, err
+	}
+
+	for uid := range requests {
+		result, ok := restoreResults[uid]
+		if !ok {
+			result.ErrorCode = user.USER_RESTORE_CHARACTER_DB_WRITE_ERROR
+		}
+
+		if result.ErrorCode != "" {
+			res.Responses = append(res.Responses, &UserRestoreCharacterResult{
+				Uid:       uid,
+				ErrorCode: result.ErrorCode,
+			})
+			continue
+		}
+
+		res.Responses = append(res.Responses, &UserRestoreCharacterResult{
+			Uid:       uid,
+			Character: result.Character,
+		})
+	}
+
+	return &res, nil
+}
+
+func (h *UserHandler) renameCharacter(ctx context.Context, body json.RawMessage) (any, error) {
+	var req RenameCharacterRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	requestCount := len(req.Requests)
+	sessions := make([]*entity.UserSession, 0, requestCount)
+	requests := make(map[string]*user.UserRenameCharacter, requestCount)
+
+	var res RenameCharacterResponse
+
+	for _, entry := range req.Requests {
+		errCode := ""
+
+		// 캐릭터 슬롯 유효성 검사
+		if user.IsInvalidCharacterSlot(entry.Slot, def.MaxCharacterSlot) {
+			errCode = user.USER_CHARACTER_SLOT_INVALID_ERROR
+		} else {
+			// 캐릭터 이름 유효성 검사
+			errCode = h.userService.ValidateCharacterName(entry.Name, h.host.GetLocale())
+		}
+
+		// 오류가 있을 경우 다음 요청으로 넘어감
+		if errCode != "" {
+			res.Responses = append(res.Responses, &UserRenameCharacterResult{
+				Uid:       entry.Uid,
+				ErrorCode: errCode,
+			})
+			continue
+		}
+
+		sessions = append(sessions, &entity.UserSession{
+			Uid:   entry.Uid,
+			Token: entry.Token,
+		})
+
+		requests[entry.Uid] = &user.UserRenameCharacter{
+			Uid:  entry.Uid,
+			Slot: entry.Slot,
+			Name: entry.Name,
+		}
+	}
+
+	_, invalidUids, err := h.authService.ValidateUserSessions(ctx, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, uid := range invalidUids {
+		delete(requests, uid)
+		res.Responses = append(res.Responses, &UserRenameCharacterResult{
+			Uid:       uid,
+			ErrorCode: session.SESSION_TOKEN_INVALID_ERROR,
+		})
+	}
+
+	renameResults, err := h.userService.RenameCharactersByUsers(ctx, func() []*user.UserRenameCharacter {
+		renameInfos := make([]*user.UserRenameCharacter, 0, len(requests))
+		for _, info := range requests {
+			renameInfos = append(renameInfos, info)
+		}
+		return renameInfos
+	}())
+	if err != nil {
+		return nil, err
+	}
+
+	for uid := range requests {
+		result, ok := renameResults[uid]
+		if !ok {
+			result.ErrorCode = user.USER_RENAME_CHARACTER_DB_WRITE_ERROR
+		}
+
+		response := &UserRenameCharacterResult{
+			Uid:       uid,
+			Character: result.Character,
+			ErrorCode: result.ErrorCode,
+		}
+		if !result.NextRenameAt.IsZero() {
+			response.NextRenameAt = &result.NextRenameAt
+		}
+		res.Responses = append(res.Responses, response)
+	}
+
+	return &res, nil
+}
+
+func (h *UserHandler) characterList(ctx context.Context, body json.RawMessage) (any, error) {
+	var req CharacterListRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	requestCount := len(req.Requests)
+	sessions := make([]*entity.UserSession, 0, requestCount)
+	requests := make(map[string]*UserCharacterListInfo, requestCount)
+	var res CharacterListResponse
+
+	for _, entry := range req.Requests {
+		sessions = append(sessions, &entity.UserSession{
+			Uid:   entry.Uid,
+			Token: entry.Token,
+		})
+
+		requests[entry.Uid] = entry
+	}
+
+	_, invalidUids, err := h.authService.ValidateUserSessions(ctx, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, uid := range invalidUids {
+		delete(requests, uid)
+		res.Responses = append(res.Responses, &UserCharacterListResult{
+			Uid:       uid,
+			ErrorCode: session.SESSION_TOKEN_INVALID_ERROR,
+		})
+	}
+
+	userCharacters, err := h.userService.FindCharactersByUids(ctx, func() []string {
+		uids := make([]string, 0, len(requests))
+		for uid := range requests {
+			uids = append(uids, uid)
+		}
+		return uids
+	}())
+
+	if err != nil {
+		return nil, err
+	}
+
+	deletedCharacters, err := h.userService.FindDeletedCharactersByUids(ctx, func() []string {
+		uids := make([]string, 0, len(requests))
+		for uid := range requests {
+			uids = append(uids, uid)
+		}
+		return uids
+	}())
+
+	if err != nil {
+		return nil, err
+	}
+
+	slotLimits, err := h.userService.FindCharacterSlotLimits(ctx, func() []string {
+		uids := make([]string, 0, len(requests))
+		for uid := range requests {
+			uids = append(uids, uid)
+		}
+		return uids
+	}())
+
+	if err != nil {
+		return nil, err
+	}
+
+	for uid := range requests {
+		characters, ok := userCharacters[uid]
+		if !ok {
+			res.Responses = append(res.Responses, &UserCharacterListResult{
+				Uid:       uid,
+				ErrorCode: user.USER_CHARACTER_LOAD_ERROR,
+			})
+			continue
+		}
+
+		if characters == nil {
+			characters = []*entity.Character{}
+		}
+
+		slices.SortFunc(characters, func(a, b *entity.Character) int {
+			return cmp.Compare(a.Slot, b.Slot)
+		})
+
+		deleted := deletedCharacters[uid]
+		slices.SortFunc(deleted, func(a, b *entity.DeletedCharacter) int {
+			return cmp.Compare(a.Character.Slot, b.Character.Slot)
+		})
+
+		res.Responses = append(res.Responses, &UserCharacterListResult{
+			Uid:               uid,
+			Characters:        characters,
+			DeletedCharacters: deleted,
+			SlotLimit:         slotLimits[uid],
+		})
+	}
+
+	return &res, nil
+}
+
+func (h *UserHandler) getCharacter(ctx context.Context, body json.RawMessage) (any, error) {
+	var req GetCharacterRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	requestCount := len(req.Requests)
+	sessions := make([]*entity.UserSession, 0, requestCount)
+	requests := make(map[string]*UserGetCharacterInfo, requestCount)
+	var res GetCharacterResponse
+
+	for _, entry := range req.Requests {
+		// 캐릭터 슬롯 유효성 검사
+		if user.IsInvalidCharacterSlot(entry.Slot, def.MaxCharacterSlot) {
+			res.Responses = append(res.Responses, &UserGetCharacterResult{
+				Uid:       entry.Uid,
+				ErrorCode: user.USER_CHARACTER_SLOT_INVALID_ERROR,
+			})
+			continue
+		}
+
+		sessions = append(sessions, &entity.UserSession{
+			Uid:   entry.Uid,
+			Token: entry.Token,
+		})
+
+		requests[entry.Uid] = entry
+	}
+
+	_, invalidUids, err := h.authService.ValidateUserSessions(ctx, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, uid := range invalidUids {
+		delete(requests, uid)
+		res.Responses = append(res.Responses, &UserGetCharacterResult{
+			Uid:       uid,
+			ErrorCode: session.SESSION_TOKEN_INVALID_ERROR,
+		})
+	}
+
+	userCharacters, err := h.userService.FindCharactersByUids(ctx, func() []string {
+		uids := make([]string, 0, len(requests))
+		for uid := range requests {
+			uids = append(uids, uid)
+		}
+		return uids
+	}())
+
+	if err != nil {
+		return nil, err
+	}
+
+	for uid, info := range requests {
+		characters, ok := userCharacters[uid]
+		if !ok {
+			res.Responses = append(res.Responses, &UserGetCharacterResult{
+				Uid:       uid,
+				ErrorCode: user.USER_CHARACTER_LOAD_ERROR,
+			})
+			continue
+		}
+
+		var foundCharacter *entity.Character
+		for _, ch := range characters {
+			if ch.Slot == info.Slot {
+				foundCharacter = ch
+				break
+			}
+		}
+
+		if foundCharacter == nil {
+			res.Responses = append(res.Responses, &UserGetCharacterResult{
+				Uid:       uid,
+				ErrorCode: user.USER_GET_CHARACTER_SLOT_NOT_FOUND_ERROR,
+			})
+			continue
+		}
+
+		res.Responses = append(res.Responses, &UserGetCharacterResult{
+			Uid:       uid,
+			Character: foundCharacter,
+		})
+	}
+
+	return &res, nil
+}
+
+func (h *UserHandler) equipCharacter(ctx context.Context, body json.RawMessage) (any, error) {
+	var req EquipCharacterRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	requestCount := len(req.Requests)
+	sessions := make([]*entity.UserSession, 0, requestCount)
+	requests := make(map[string]*user.UserEquipCharacter, requestCount)
+	var res EquipCharacterResponse
+
+	for _, entry := range req.Requests {
+		// 캐릭터 슬롯 유효성 검사
+		if user.IsInvalidCharacterSlot(entry.Slot, def.MaxCharacterSlot) {
+			res.Responses = append(res.Responses, &UserCharacterEquipResult{
+				Uid:       entry.Uid,
+				ErrorCode: user.USER_CHARACTER_SLOT_INVALID_ERROR,
+			})
+			continue
+		}
+
+		sessions = append(sessions, &entity.UserSession{
+			Uid:   entry.Uid,
+			Token: entry.Token,
+		})
+
+		requests[entry.Uid] = &user.UserEquipCharacter{
+			Uid:   entry.Uid,
+			Slot:  entry.Slot,
+			Index: entry.Index,
+		}
+	}
+
+	_, invalidUids, err := h.authService.ValidateUserSessions(ctx, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, uid := range invalidUids {
+		delete(requests, uid)
+		res.Responses = append(res.Responses, &UserCharacterEquipResult{
+			Uid:       uid,
+			ErrorCode: session.SESSION_TOKEN_INVALID_ERROR,
+		})
+	}
+
+	results, err := h.userService.EquipCharacterItems(ctx, func() []*user.UserEquipCharacter {
+		infos := make([]*user.UserEquipCharacter, 0, len(requests))
+		for _, info := range requests {
+			infos = append(infos, info)
+		}
+		return infos
+	}())
+
+	if err != nil {
+		return nil, err
+	}
+
+	for uid, info := range requests {
+		result, ok := results[uid]
+		if !ok {
+			res.Responses = append(res.Responses, &UserCharacterEquipResult{
+				Uid:       uid,
+				ErrorCode: user.USER_EQUIP_CHARACTER_DB_WRITE_ERROR,
+			})
+			continue
+		}
+
+		if result.ErrorCode != "" {
+			res.Responses = append(res.Responses, &UserCharacterEquipResult{
+				Uid:       uid,
+				ErrorCode: result.ErrorCode,
+			})
+			continue
+		}
+
+		res.Responses = append(res.Responses, &UserCharacterEquipResult{
+			Uid:    uid,
+			Slot:   info.Slot,
+			Equips: result.Equips,
+		})
+	}
+
+	return &res, nil
+}
+
+func (h *UserHandler) unequipCharacter(ctx context.Context, body json.RawMessage) (any, error) {
+	var req UnequipCharacterRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	requestCount := len(req.Requests)
+	sessions := make([]*entity.UserSession, 0, requestCount)
+	requests := make(map[string]*user.UserUnequipCharacter, requestCount)
+	var res UnequipCharacterResponse
+
+	for _, entry := range req.Requests {
+		// 캐릭터 슬롯 유효성 검사
+		if user.IsInvalidCharacterSlot(entry.Slot, def.MaxCharacterSlot) {
+			res.Responses = append(res.Responses, &UserCharacterEquipResult{
+				Uid:       entry.Uid,
+				ErrorCode: user.USER_CHARACTER_SLOT_INVALID_ERROR,
+			})
+			continue
+		}
+
+		sessions = append(sessions, &entity.UserSession{
+			Uid:   entry.Uid,
+			Token: entry.Token,
+		})
+
+		requests[entry.Uid] = &user.UserUnequipCharacter{
+			Uid:  entry.Uid,
+			Slot: entry.Slot,
+			Type: entry.Type,
+		}
+	}
+
+	_, invalidUids, err := h.authService.ValidateUserSessions(ctx, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, uid := range invalidUids {
+		delete(requests, uid)
+		res.Responses = append(res.Responses, &UserCharacterEquipResult{
+			Uid:       uid,
+			ErrorCode: session.SESSION_TOKEN_INVALID_ERROR,
+		})
+	}
+
+	results, err := h.userService.UnequipCharacterItems(ctx, func() []*user.UserUnequipCharacter {
+		infos := make([]*user.UserUnequipCharacter, 0, len(requests))
+		for _, info := range requests {
+			infos = append(infos, info)
+		}
+		return infos
+	}())
+
+	if err != nil {
+		return nil, err
+	}
+
+	for uid, info := range requests {
+		result, ok := results[uid]
+		if !ok {
+			res.Responses = append(res.Responses, &UserCharacterEquipResult{
+				Uid:       uid,
+				ErrorCode: user.USER_EQUIP_CHARACTER_DB_WRITE_ERROR,
+			})
+			continue
+		}
+
+		if result.ErrorCode != "" {
+			res.Responses = append(res.Responses, &UserCharacterEquipResult{
+				Uid:       uid,
+				ErrorCode: result.ErrorCode,
+			})
+			continue
+		}
+
+		res.Responses = append(res.Responses, &UserCharacterEquipResult{
+			Uid:    uid,
+			Slot:   info.Slot,
+			Equips: result.Equips,
+		})
+	}
+
+	return &res, nil
+}
+
+func (h *UserHandler) expandCharacterSlot(ctx context.Context, body json.RawMessage) (any, error) {
+	var req ExpandCharacterSlotRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+
+	requestCount := len(req.Requests)
+	sessions := make([]*entity.UserSession, 0, requestCount)
+	requests := make(map[string]*UserExpandCharacterSlotInfo, requestCount)
+	var res ExpandCharacterSlotResponse
+
+	for _, entry := range req.Requests {
+		sessions = append(sessions, &entity.UserSession{
+			Uid:   entry.Uid,
+			Token: entry.Token,
+		})
+
+		requests[entry.Uid] = entry
+	}
+
+	_, invalidUids, err := h.authService.ValidateUserSessions(ctx, sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, uid := range invalidUids {
+		delete(requests, uid)
+		res.Responses = append(res.Responses, &UserExpandCharacterSlotResult{
+			Uid:       uid,
+			ErrorCode: session.SESSION_TOKEN_INVALID_ERROR,
+		})
+	}
+
+	expandResults, err := h.userService.ExpandCharacterSlots(ctx, func() []string {
+		uids := make([]string, 0, len(requests))
+		for uid := range requests {
+			uids = append(uids, uid)
+		}
+		return uids
+	}())
+
+	if err != nil {
+		return nil, err
+	}
+
+	for uid := range requests {
+		result, ok := expandResults[uid]
+		if !ok {
+			result.ErrorCode = user.USER_EXPAND_CHARACTER_SLOT_DB_WRITE_ERROR
+		}
+
+		res.Responses = append(res.Responses, &UserExpandCharacterSlotResult{
+			Uid:       uid,
+			SlotLimit: result.SlotLimit,
+			ErrorCode: result.ErrorCode,
+		})
+	}
+
+	return &res, nil
+}
+
+// 캐릭터 생성 핸들러
+func (h *UserHandler) onCreateCharacter(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.createCharacter(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*CreateCharacterResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(&res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// 캐릭터 이름 중복 확인 핸들러
+func (h *UserHandler) onCheckCharacterName(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.checkCharacterName(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*CheckCharacterNameResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// 캐릭터 생성 미리보기 핸들러
+func (h *UserHandler) onPreviewCharacter(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.previewCharacter(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*PreviewCharacterResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// 캐릭터 삭제 핸들러
+func (h *UserHandler) onDeleteCharacter(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 	defer r.Body.Close()
@@ -473,3 +1356,206 @@ func (h *UserHandler) onDeleteCharacter(w http.ResponseWriter, r *http.Request)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 	}
 }
+
+// 캐릭터 복구 핸들러
+func (h *UserHandler) onRestoreCharacter(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.restoreCharacter(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*RestoreCharacterResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(&res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// 캐릭터 이름 변경 핸들러
+func (h *UserHandler) onRenameCharacter(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.renameCharacter(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*RenameCharacterResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(&res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// 캐릭터 목록 핸들러
+func (h *UserHandler) onCharacterList(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.characterList(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*CharacterListResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(&res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// 캐릭터 상세 조회 핸들러
+func (h *UserHandler) onGetCharacter(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.getCharacter(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*GetCharacterResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(&res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// 캐릭터 장비 장착 핸들러
+func (h *UserHandler) onEquipCharacter(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.equipCharacter(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*EquipCharacterResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(&res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// 캐릭터 장비 해제 핸들러
+func (h *UserHandler) onUnequipCharacter(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.unequipCharacter(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*UnequipCharacterResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(&res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// 캐릭터 슬롯 확장 핸들러
+func (h *UserHandler) onExpandCharacterSlot(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	ret, err := h.expandCharacterSlot(r.Context(), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	res, ok := ret.(*ExpandCharacterSlotResponse)
+	if !ok {
+		http.Error(w, "응답 변환에 실패했습니다.", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(&res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
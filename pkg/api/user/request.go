@@ -1,5 +1,7 @@
 package user
 
+import "MScannot206/shared/types"
+
 // 캐릭터 이름 검사 요청 정보
 type UserNameCheckInfo struct {
 	// 유저 고유 ID
@@ -10,6 +12,9 @@ type UserNameCheckInfo struct {
 
 	// 검사할 이름
 	Name string `json:"name"`
+
+	// 사용 가능한 이름을 잠시 예약할지 여부 (예약한 이름은 예약한 유저만 캐릭터 생성에 사용할 수 있습니다)
+	Reserve bool `json:"reserve,omitempty"`
 }
 
 // 캐릭터 이름 검사 요청
@@ -34,6 +39,9 @@ type UserCreateCharacterInfo struct {
 
 	// 생성할 캐릭터 성별 (1: 남성, 2: 여성)
 	Gender int `json:"gender"`
+
+	// 직접 선택할 외형 (hair/face/skin/ear, 생략한 종류는 랜덤으로 결정됩니다)
+	Appearance map[types.CharacterEquipType]string `json:"appearance,omitempty"`
 }
 
 // 캐릭터 생성 요청
@@ -42,6 +50,30 @@ type CreateCharacterRequest struct {
 	Requests []*UserCreateCharacterInfo `json:"requests"`
 }
 
+// 캐릭터 생성 미리보기 요청 정보
+type UserPreviewCharacterInfo struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 인증 토큰
+	Token string `json:"token"`
+
+	// 생성할 캐릭터 슬롯 번호
+	Slot int `json:"slot"`
+
+	// 생성할 캐릭터 성별 (1: 남성, 2: 여성)
+	Gender int `json:"gender"`
+
+	// 직접 선택할 외형 (hair/face/skin/ear, 생략한 종류는 랜덤으로 결정됩니다)
+	Appearance map[types.CharacterEquipType]string `json:"appearance,omitempty"`
+}
+
+// 캐릭터 생성 미리보기 요청
+type PreviewCharacterRequest struct {
+	// 미리보기 요청 목록
+	Requests []*UserPreviewCharacterInfo `json:"requests"`
+}
+
 // 캐릭터 삭제 요청 정보
 type UserDeleteCharacterInfo struct {
 	// 유저 고유 ID
@@ -59,3 +91,132 @@ type DeleteCharacterRequest struct {
 	// 삭제 요청 목록
 	Requests []*UserDeleteCharacterInfo `json:"requests"`
 }
+
+// 캐릭터 복구 요청 정보
+type UserRestoreCharacterInfo struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 인증 토큰
+	Token string `json:"token"`
+
+	// 복구할 캐릭터 슬롯 번호
+	Slot int `json:"slot"`
+}
+
+// 캐릭터 복구 요청
+type RestoreCharacterRequest struct {
+	// 복구 요청 목록
+	Requests []*UserRestoreCharacterInfo `json:"requests"`
+}
+
+// 캐릭터 이름 변경 요청 정보
+type UserRenameCharacterInfo struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 인증 토큰
+	Token string `json:"token"`
+
+	// 이름을 변경할 캐릭터 슬롯 번호
+	Slot int `json:"slot"`
+
+	// 변경할 캐릭터 이름
+	Name string `json:"name"`
+}
+
+// 캐릭터 이름 변경 요청
+type RenameCharacterRequest struct {
+	// 이름 변경 요청 목록
+	Requests []*UserRenameCharacterInfo `json:"requests"`
+}
+
+// 캐릭터 목록 요청 정보
+type UserCharacterListInfo struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 인증 토큰
+	Token string `json:"token"`
+}
+
+// 캐릭터 목록 요청
+type CharacterListRequest struct {
+	// 목록 요청 목록
+	Requests []*UserCharacterListInfo `json:"requests"`
+}
+
+// 캐릭터 상세 조회 요청 정보
+type UserGetCharacterInfo struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 인증 토큰
+	Token string `json:"token"`
+
+	// 조회할 캐릭터 슬롯 번호
+	Slot int `json:"slot"`
+}
+
+// 캐릭터 상세 조회 요청
+type GetCharacterRequest struct {
+	// 조회 요청 목록
+	Requests []*UserGetCharacterInfo `json:"requests"`
+}
+
+// 캐릭터 장비 장착 요청 정보
+type UserEquipCharacterInfo struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 인증 토큰
+	Token string `json:"token"`
+
+	// 장착할 캐릭터 슬롯 번호
+	Slot int `json:"slot"`
+
+	// 장착할 장비 아이템 인덱스
+	Index string `json:"index"`
+}
+
+// 캐릭터 장비 장착 요청
+type EquipCharacterRequest struct {
+	// 장착 요청 목록
+	Requests []*UserEquipCharacterInfo `json:"requests"`
+}
+
+// 캐릭터 장비 해제 요청 정보
+type UserUnequipCharacterInfo struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 인증 토큰
+	Token string `json:"token"`
+
+	// 해제할 캐릭터 슬롯 번호
+	Slot int `json:"slot"`
+
+	// 해제할 장비 종류
+	Type types.CharacterEquipType `json:"type"`
+}
+
+// 캐릭터 장비 해제 요청
+type UnequipCharacterRequest struct {
+	// 해제 요청 목록
+	Requests []*UserUnequipCharacterInfo `json:"requests"`
+}
+
+// 캐릭터 슬롯 확장 요청 정보
+type UserExpandCharacterSlotInfo struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 인증 토큰
+	Token string `json:"token"`
+}
+
+// 캐릭터 슬롯 확장 요청
+type ExpandCharacterSlotRequest struct {
+	// 확장 요청 목록
+	Requests []*UserExpandCharacterSlotInfo `json:"requests"`
+}
@@ -1,12 +1,18 @@
 package user
 
-import "MScannot206/shared/entity"
+import (
+	"MScannot206/shared/entity"
+	"time"
+)
 
 // 캐릭터 이름 검사 결과
 type UserNameCheckResult struct {
 	// 유저 고유 ID
 	Uid string `json:"uid"`
 
+	// 이름 예약 만료 일시 (예약한 경우에만 존재합니다)
+	ReservedUntil *time.Time `json:"reserved_until,omitempty"`
+
 	// 이름 사용 가능 여부
 	ErrorCode string `json:"error_code,omitempty"`
 }
@@ -37,6 +43,30 @@ type CreateCharacterResponse struct {
 	Responses []*UserCreateCharacterResult `json:"responses"`
 }
 
+// 캐릭터 생성 미리보기 결과
+type UserPreviewCharacterResult struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 미리보기로 결정된 장비 정보
+	Equips []*entity.CharacterEquip `json:"equips,omitempty"`
+
+	// 남은 다시 굴리기 횟수
+	RerollsLeft int `json:"rerolls_left"`
+
+	// 초안 만료 일시 (만료 전에 캐릭터를 생성해야 미리보기한 장비로 생성됩니다)
+	ExpireAt *time.Time `json:"expire_at,omitempty"`
+
+	// 미리보기 오류 코드
+	ErrorCode string `json:"error_code,omitempty"`
+}
+
+// 캐릭터 생성 미리보기 응답
+type PreviewCharacterResponse struct {
+	// 미리보기 결과 목록
+	Responses []*UserPreviewCharacterResult `json:"responses"`
+}
+
 // 캐릭터 삭제 결과
 type UserDeleteCharacterResult struct {
 	// 유저 고유 ID
@@ -45,6 +75,9 @@ type UserDeleteCharacterResult struct {
 	// 삭제된 캐릭터 슬롯 번호
 	Slot int `json:"slot"`
 
+	// 완전 삭제 일시 (이전까지 복구할 수 있습니다)
+	PurgeAt *time.Time `json:"purge_at,omitempty"`
+
 	// 삭제 오류 코드
 	ErrorCode string `json:"error_code,omitempty"`
 }
@@ -54,3 +87,129 @@ type DeleteCharacterResponse struct {
 	// 삭제 결과 목록
 	Responses []*UserDeleteCharacterResult `json:"responses"`
 }
+
+// 캐릭터 복구 결과
+type UserRestoreCharacterResult struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 복구된 캐릭터 정보
+	Character *entity.Character `json:"character,omitempty"`
+
+	// 복구 오류 코드
+	ErrorCode string `json:"error_code,omitempty"`
+}
+
+// 캐릭터 복구 응답
+type RestoreCharacterResponse struct {
+	// 복구 결과 목록
+	Responses []*UserRestoreCharacterResult `json:"responses"`
+}
+
+// 캐릭터 이름 변경 결과
+type UserRenameCharacterResult struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 이름이 변경된 캐릭터 정보
+	Character *entity.Character `json:"character,omitempty"`
+
+	// 다음으로 이름을 변경할 수 있는 일시 (성공 또는 대기 시간 오류 시 존재합니다)
+	NextRenameAt *time.Time `json:"next_rename_at,omitempty"`
+
+	// 이름 변경 오류 코드
+	ErrorCode string `json:"error_code,omitempty"`
+}
+
+// 캐릭터 이름 변경 응답
+type RenameCharacterResponse struct {
+	// 이름 변경 결과 목록
+	Responses []*UserRenameCharacterResult `json:"responses"`
+}
+
+// 캐릭터 목록 결과
+type UserCharacterListResult struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 보유 캐릭터 목록 (슬롯 순)
+	Characters []*entity.Character `json:"characters"`
+
+	// 완전 삭제 전인 삭제된 캐릭터 목록 (슬롯 순)
+	DeletedCharacters []*entity.DeletedCharacter `json:"deleted_characters,omitempty"`
+
+	// 캐릭터 슬롯 수
+	SlotLimit int `json:"slot_limit,omitempty"`
+
+	// 조회 오류 코드
+	ErrorCode string `json:"error_code,omitempty"`
+}
+
+// 캐릭터 목록 응답
+type CharacterListResponse struct {
+	// 목록 결과 목록
+	Responses []*UserCharacterListResult `json:"responses"`
+}
+
+// 캐릭터 상세 조회 결과
+type UserGetCharacterResult struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 조회된 캐릭터 정보
+	Character *entity.Character `json:"character,omitempty"`
+
+	// 조회 오류 코드
+	ErrorCode string `json:"error_code,omitempty"`
+}
+
+// 캐릭터 상세 조회 응답
+type GetCharacterResponse struct {
+	// 조회 결과 목록
+	Responses []*UserGetCharacterResult `json:"responses"`
+}
+
+// 캐릭터 장비 변경 결과
+type UserCharacterEquipResult struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 캐릭터 슬롯 번호
+	Slot int `json:"slot,omitempty"`
+
+	// 변경된 캐릭터 장비 목록
+	Equips []*entity.CharacterEquip `json:"equips,omitempty"`
+
+	// 변경 오류 코드
+	ErrorCode string `json:"error_code,omitempty"`
+}
+
+// 캐릭터 장비 장착 응답
+type EquipCharacterResponse struct {
+	// 장착 결과 목록
+	Responses []*UserCharacterEquipResult `json:"responses"`
+}
+
+// 캐릭터 장비 해제 응답
+type UnequipCharacterResponse struct {
+	// 해제 결과 목록
+	Responses []*UserCharacterEquipResult `json:"responses"`
+}
+
+// 캐릭터 슬롯 확장 결과
+type UserExpandCharacterSlotResult struct {
+	// 유저 고유 ID
+	Uid string `json:"uid"`
+
+	// 확장 후 캐릭터 슬롯 수 (실패 시 현재 슬롯 수)
+	SlotLimit int `json:"slot_limit,omitempty"`
+
+	// 확장 오류 코드
+	ErrorCode string `json:"error_code,omitempty"`
+}
+
+// 캐릭터 슬롯 확장 응답
+type ExpandCharacterSlotResponse struct {
+	// 확장 결과 목록
+	Responses []*UserExpandCharacterSlotResult `json:"responses"`
+}
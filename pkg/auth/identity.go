@@ -0,0 +1,248 @@
+package auth
+
+import (
+	"MScannot206/pkg/auth/identity"
+	"MScannot206/shared/entity"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// 인증 결과
+type IdentityResult struct {
+	// 인증에 성공한 유저 고유 ID
+	Uid string
+
+	// 인증에 실패한 경우 오류 코드
+	ErrorCode string
+}
+
+// 인증 수단을 등록합니다. 같은 이름의 인증 수단은 등록할 수 없습니다
+func (s *AuthService) RegisterIdentityProvider(provider identity.Provider) error {
+	if provider == nil {
+		return identity.ErrProviderIsNil
+	}
+
+	name := provider.Name()
+	if _, ok := s.providers[name]; ok {
+		return fmt.Errorf("%w: %v", identity.ErrProviderAlreadyRegistered, name)
+	}
+
+	s.providers[name] = provider
+	return nil
+}
+
+// 이전 버전의 유저 고유 ID 로그인을 허용합니다
+// 인증 수단을 연결하지 않은 기존 유저만 유저 고유 ID로 로그인할 수 있으며, 유저가 인증 수단을 연결하면 더 이상 허용하지 않습니다
+func (s *AuthService) EnableLegacyUidLogin() {
+	s.legacyUidLogin = true
+}
+
+// 인증 정보를 검증하고 연결된 유저 고유 ID를 반환합니다
+// 반환되는 인증 결과는 전달한 인증 정보와 같은 순서이며, 계정이 없으면 인증 수단이 허용하는 경우 새 유저 고유 ID로 계정을 만듭니다
+func (s *AuthService) AuthenticateIdentities(ctx context.Context, creds []*identity.Credential) ([]*IdentityResult, error) {
+	results := make([]*IdentityResult, len(creds))
+
+	// 인증 정보 고유 ID 계산
+	ids := make([]string, len(creds))
+	targetIds := make([]string, 0, len(creds))
+	legacyIndexes := make([]int, 0)
+	for i, cred := range creds {
+		results[i] = &IdentityResult{}
+
+		if cred.Provider == identity.ProviderLegacy && s.legacyUidLogin {
+			legacyIndexes = append(legacyIndexes, i)
+			continue
+		}
+
+		provider, ok := s.providers[cred.Provider]
+		if !ok {
+			results[i].ErrorCode = identity.IDENTITY_PROVIDER_NOT_SUPPORTED_ERROR
+			continue
+		}
+
+		subject, errCode := provider.Subject(cred)
+		if errCode != "" {
+			results[i].ErrorCode = errCode
+			continue
+		}
+
+		ids[i] = identity.IdentityId(provider.Name(), subject)
+		targetIds = append(targetIds, ids[i])
+	}
+
+	identities, err := s.identityRepo.FindIdentities(ctx, targetIds)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, cred := range creds {
+		if ids[i] == "" {
+			continue
+		}
+
+		provider := s.providers[cred.Provider]
+		if found, ok := identities[ids[i]]; ok {
+			results[i].Uid, results[i].ErrorCode = s.verifyIdentity(provider, cred, found)
+			continue
+		}
+
+		created, errCode := s.registerIdentity(ctx, provider, cred, ids[i])
+		if errCode != "" {
+			results[i].ErrorCode = errCode
+			continue
+		}
+
+		// 같은 요청에 같은 인증 정보가 여러 번 있으면 방금 만든 계정으로 검증합니다
+		identities[ids[i]] = created
+		results[i].Uid = created.Uid
+	}
+
+	if len(legacyIndexes) > 0 {
+		if err := s.authenticateLegacyUids(ctx, creds, legacyIndexes, results); err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// 이전 버전의 유저 고유 ID 로그인을 검증합니다
+// 이미 있는 유저만 로그인할 수 있으며, 인증 수단이 연결된 유저는 연결한 인증 수단으로만 로그인할 수 있습니다
+func (s *AuthService) authenticateLegacyUids(ctx context.Context, creds []*identity.Credential, indexes []int, results []*IdentityResult) error {
+	uids := make([]string, 0, len(indexes))
+	for _, i := range indexes {
+		if creds[i].Uid != "" {
+			uids = append(uids, creds[i].Uid)
+		}
+	}
+
+	userUids, err := s.identityRepo.FindUserUids(ctx, uids)
+	if err != nil {
+		return err
+	}
+
+	linkedUids, err := s.identityRepo.FindLinkedUids(ctx, uids)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range indexes {
+		uid := creds[i].Uid
+		switch {
+		case uid == "" || !slices.Contains(userUids, uid):
+			results[i].ErrorCode = identity.IDENTITY_ACCOUNT_NOT_FOUND_ERROR
+		case slices.Contains(linkedUids, uid):
+			results[i].ErrorCode = identity.IDENTITY_LEGACY_UID_LINKED_ERROR
+		default:
+			results[i].Uid = uid
+		}
+	}
+
+	return nil
+}
+
+// 인증 수단 연결 요청
+type IdentityLink struct {
+	// 인증 정보를 연결할 유저 고유 ID (세션 검증을 마친 유저여야 합니다)
+	Uid string
+
+	// 연결할 인증 정보
+	Credential *identity.Credential
+}
+
+// 유저에게 새 인증 정보를 연결합니다
+// 이전 버전의 유저는 인증 수단을 연결한 뒤부터 연결한 인증 수단으로만 로그인할 수 있습니다
+// 반환되는 오류 코드는 전달한 요청과 같은 순서이며, 성공한 요청은 빈 문자열입니다
+func (s *AuthService) LinkIdentities(ctx context.Context, links []*IdentityLink) ([]string, error) {
+	errCodes := make([]string, len(links))
+
+	for i, link := range links {
+		provider, ok := s.providers[link.Credential.Provider]
+		if !ok {
+			errCodes[i] = identity.IDENTITY_PROVIDER_NOT_SUPPORTED_ERROR
+			continue
+		}
+
+		subject, errCode := provider.Subject(link.Credential)
+		if errCode != "" {
+			errCodes[i] = errCode
+			continue
+		}
+
+		// 연결은 새 계정 생성과 같으므로 계정 생성을 요청한 것으로 처리합니다
+		cred := *link.Credential
+		cred.Register = true
+
+		newIdentity := &entity.UserIdentity{
+			Id:       identity.IdentityId(provider.Name(), subject),
+			Provider: provider.Name(),
+			Subject:  subject,
+			Uid:      link.Uid,
+		}
+
+		if errCode := provider.Register(&cred, newIdentity); errCode != "" {
+			errCodes[i] = errCode
+			continue
+		}
+
+		err := s.identityRepo.InsertIdentity(ctx, newIdentity)
+		if mongo.IsDuplicateKeyError(err) {
+			errCodes[i] = identity.IDENTITY_ALREADY_LINKED_ERROR
+		} else if err != nil {
+			log.Err(err).Msgf("인증 정보 연결 실패: %v", newIdentity.Id)
+			errCodes[i] = identity.IDENTITY_DB_WRITE_ERROR
+		}
+	}
+
+	return errCodes, nil
+}
+
+func (s *AuthService) verifyIdentity(provider identity.Provider, cred *identity.Credential, found *entity.UserIdentity) (string, string) {
+	if errCode := provider.Verify(cred, found); errCode != "" {
+		return "", errCode
+	}
+	return found.Uid, ""
+}
+
+// 새 계정을 만들고 저장된 계정을 반환합니다
+func (s *AuthService) registerIdentity(ctx context.Context, provider identity.Provider, cred *identity.Credential, id string) (*entity.UserIdentity, string) {
+	subject := id[len(provider.Name())+1:]
+
+	newIdentity := &entity.UserIdentity{
+		Id:       id,
+		Provider: provider.Name(),
+		Subject:  subject,
+		Uid:      primitive.NewObjectID().Hex(),
+	}
+
+	if errCode := provider.Register(cred, newIdentity); errCode != "" {
+		return nil, errCode
+	}
+
+	err := s.identityRepo.InsertIdentity(ctx, newIdentity)
+	if err == nil {
+		return newIdentity, ""
+	}
+
+	if !mongo.IsDuplicateKeyError(err) {
+		log.Err(err).Msgf("인증 정보 저장 실패: %v", id)
+		return nil, identity.IDENTITY_DB_WRITE_ERROR
+	}
+
+	// 같은 인증 정보로 동시에 계정을 만든 경우 먼저 저장된 계정으로 검증합니다
+	found, err := s.identityRepo.FindIdentity(ctx, id)
+	if err != nil || found == nil {
+		log.Err(err).Msgf("인증 정보 재조회 실패: %v", id)
+		return nil, identity.IDENTITY_DB_WRITE_ERROR
+	}
+
+	if errCode := provider.Verify(cred, found); errCode != "" {
+		return nil, errCode
+	}
+	return found, ""
+}
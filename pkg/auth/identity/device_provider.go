@@ -0,0 +1,43 @@
+package identity
+
+import (
+	"MScannot206/shared/entity"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// 기기 ID 길이 제한
+const (
+	MinDeviceIdLength = 16
+	MaxDeviceIdLength = 128
+)
+
+func NewDeviceProvider() (*DeviceProvider, error) {
+	return &DeviceProvider{}, nil
+}
+
+// 기기 ID(게스트) 인증 수단
+// 처음 로그인한 기기 ID는 새 계정을 만들며, 기기 ID 원문 대신 SHA-256 해시를 계정 ID로 저장합니다
+type DeviceProvider struct {
+}
+
+func (p *DeviceProvider) Name() string {
+	return ProviderDevice
+}
+
+func (p *DeviceProvider) Subject(cred *Credential) (string, string) {
+	if len(cred.DeviceId) < MinDeviceIdLength || len(cred.DeviceId) > MaxDeviceIdLength {
+		return "", IDENTITY_DEVICE_ID_INVALID_ERROR
+	}
+
+	sum := sha256.Sum256([]byte(cred.DeviceId))
+	return hex.EncodeToString(sum[:]), ""
+}
+
+func (p *DeviceProvider) Verify(cred *Credential, identity *entity.UserIdentity) string {
+	return ""
+}
+
+func (p *DeviceProvider) Register(cred *Credential, identity *entity.UserIdentity) string {
+	return ""
+}
@@ -0,0 +1,50 @@
+package identity
+
+import (
+	"MScannot206/shared"
+	"fmt"
+)
+
+const IDENTITY_PROVIDER_NOT_SUPPORTED_ERROR = "IDENTITY_PROVIDER_NOT_SUPPORTED_ERROR"
+const IDENTITY_ACCOUNT_NOT_FOUND_ERROR = "IDENTITY_ACCOUNT_NOT_FOUND_ERROR"
+const IDENTITY_DB_WRITE_ERROR = "IDENTITY_DB_WRITE_ERROR"
+const IDENTITY_ALREADY_LINKED_ERROR = "IDENTITY_ALREADY_LINKED_ERROR"
+
+// password
+const IDENTITY_USERNAME_INVALID_ERROR = "IDENTITY_USERNAME_INVALID_ERROR"
+const IDENTITY_PASSWORD_INVALID_ERROR = "IDENTITY_PASSWORD_INVALID_ERROR"
+const IDENTITY_PASSWORD_MISMATCH_ERROR = "IDENTITY_PASSWORD_MISMATCH_ERROR"
+const IDENTITY_USERNAME_ALREADY_EXISTS_ERROR = "IDENTITY_USERNAME_ALREADY_EXISTS_ERROR"
+
+// device
+const IDENTITY_DEVICE_ID_INVALID_ERROR = "IDENTITY_DEVICE_ID_INVALID_ERROR"
+
+// id token
+const IDENTITY_ID_TOKEN_INVALID_ERROR = "IDENTITY_ID_TOKEN_INVALID_ERROR"
+const IDENTITY_ID_TOKEN_EXPIRED_ERROR = "IDENTITY_ID_TOKEN_EXPIRED_ERROR"
+
+// legacy
+const IDENTITY_LEGACY_UID_LINKED_ERROR = "IDENTITY_LEGACY_UID_LINKED_ERROR"
+
+func init() {
+	shared.RegisterError(IDENTITY_PROVIDER_NOT_SUPPORTED_ERROR, "지원하지 않는 인증 수단입니다")
+	shared.RegisterError(IDENTITY_ACCOUNT_NOT_FOUND_ERROR, "계정을 찾을 수 없습니다")
+	shared.RegisterError(IDENTITY_DB_WRITE_ERROR, "계정 저장 중 데이터베이스 쓰기 오류가 발생하였습니다")
+	shared.RegisterError(IDENTITY_ALREADY_LINKED_ERROR, "이미 계정에 연결된 인증 정보입니다")
+
+	// password
+	shared.RegisterError(IDENTITY_USERNAME_INVALID_ERROR, fmt.Sprintf("아이디는 영문, 숫자, _ 로 %d~%d자여야 합니다", MinUsernameLength, MaxUsernameLength))
+	shared.RegisterError(IDENTITY_PASSWORD_INVALID_ERROR, fmt.Sprintf("비밀번호는 %d~%d바이트여야 합니다", MinPasswordLength, MaxPasswordLength))
+	shared.RegisterError(IDENTITY_PASSWORD_MISMATCH_ERROR, "아이디 또는 비밀번호가 일치하지 않습니다")
+	shared.RegisterError(IDENTITY_USERNAME_ALREADY_EXISTS_ERROR, "이미 사용 중인 아이디입니다")
+
+	// device
+	shared.RegisterError(IDENTITY_DEVICE_ID_INVALID_ERROR, fmt.Sprintf("기기 ID는 %d~%d자여야 합니다", MinDeviceIdLength, MaxDeviceIdLength))
+
+	// id token
+	shared.RegisterError(IDENTITY_ID_TOKEN_INVALID_ERROR, "ID 토큰이 유효하지 않습니다")
+	shared.RegisterError(IDENTITY_ID_TOKEN_EXPIRED_ERROR, "ID 토큰이 만료되었습니다")
+
+	// legacy
+	shared.RegisterError(IDENTITY_LEGACY_UID_LINKED_ERROR, "인증 수단이 연결된 계정은 유저 고유 ID로 로그인할 수 없습니다")
+}
@@ -0,0 +1,61 @@
+package identity
+
+import (
+	"MScannot206/shared/entity"
+	"errors"
+	"time"
+)
+
+// 외부 ID 토큰 검증기
+// 토큰을 검증하고 토큰 발급자 내 계정 ID(sub)를 반환합니다
+type IdTokenVerifier interface {
+	Verify(token string, now time.Time) (string, error)
+}
+
+func NewIdTokenProvider(name string, verifier IdTokenVerifier) (*IdTokenProvider, error) {
+	if name == "" {
+		return nil, errors.New("id token provider name is empty")
+	}
+	if verifier == nil {
+		return nil, ErrIdTokenVerifierIsNil
+	}
+
+	return &IdTokenProvider{
+		name:     name,
+		verifier: verifier,
+	}, nil
+}
+
+// 외부 ID 토큰 인증 수단
+// 외부 서비스가 발급한 ID 토큰을 설정된 공개 키로 서버에서 직접 검증하며, 처음 로그인한 계정은 새 계정을 만듭니다
+type IdTokenProvider struct {
+	name     string
+	verifier IdTokenVerifier
+}
+
+func (p *IdTokenProvider) Name() string {
+	return p.name
+}
+
+func (p *IdTokenProvider) Subject(cred *Credential) (string, string) {
+	if cred.IdToken == "" {
+		return "", IDENTITY_ID_TOKEN_INVALID_ERROR
+	}
+
+	subject, err := p.verifier.Verify(cred.IdToken, time.Now())
+	if errors.Is(err, ErrIdTokenExpired) {
+		return "", IDENTITY_ID_TOKEN_EXPIRED_ERROR
+	} else if err != nil {
+		return "", IDENTITY_ID_TOKEN_INVALID_ERROR
+	}
+
+	return subject, ""
+}
+
+func (p *IdTokenProvider) Verify(cred *Credential, identity *entity.UserIdentity) string {
+	return ""
+}
+
+func (p *IdTokenProvider) Register(cred *Credential, identity *entity.UserIdentity) string {
+	return ""
+}
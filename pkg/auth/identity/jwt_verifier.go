@@ -0,0 +1,237 @@
+package identity
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+)
+
+// 토큰 만료, 활성 시각 검사에 허용하는 서버 간 시간 오차
+const idTokenLeeway = 60 * time.Second
+
+var ErrIdTokenVerifierIsNil = errors.New("id token verifier is null")
+var ErrIdTokenMalformed = errors.New("id token is malformed")
+var ErrIdTokenSignature = errors.New("id token signature is invalid")
+var ErrIdTokenClaims = errors.New("id token claims are invalid")
+var ErrIdTokenExpired = errors.New("id token is expired")
+var ErrUnsupportedPublicKey = errors.New("unsupported public key")
+var ErrIdTokenIssuerIsEmpty = errors.New("id token issuer is empty")
+var ErrIdTokenAudienceIsEmpty = errors.New("id token audience is empty")
+
+// PEM 파일의 공개 키로 JWT 형식의 ID 토큰을 검증하는 검증기를 만듭니다
+// 다른 서비스에 발급된 토큰을 허용하지 않도록 issuer, audience는 반드시 설정해야 합니다
+func NewJwtVerifierFromFile(path string, issuer string, audience string) (*JwtVerifier, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := parsePublicKeyPem(data)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", path, err)
+	}
+
+	return NewJwtVerifier(key, issuer, audience)
+}
+
+// 공개 키로 JWT 형식의 ID 토큰을 검증하는 검증기를 만듭니다
+// 공개 키 종류에 따라 RS256(RSA), ES256(ECDSA P-256), EdDSA(Ed25519) 서명만 허용합니다
+// 토큰의 발급자(iss)와 대상(aud)이 issuer, audience와 같아야 하며, 둘 중 하나라도 비어 있으면 검증기를 만들지 않습니다
+func NewJwtVerifier(key crypto.PublicKey, issuer string, audience string) (*JwtVerifier, error) {
+	if issuer == "" {
+		return nil, ErrIdTokenIssuerIsEmpty
+	}
+	if audience == "" {
+		return nil, ErrIdTokenAudienceIsEmpty
+	}
+
+	var alg string
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		alg = "RS256"
+	case *ecdsa.PublicKey:
+		if k.Curve.Params().Name != "P-256" {
+			return nil, ErrUnsupportedPublicKey
+		}
+		alg = "ES256"
+	case ed25519.PublicKey:
+		alg = "EdDSA"
+	default:
+		return nil, ErrUnsupportedPublicKey
+	}
+
+	return &JwtVerifier{
+		key:      key,
+		alg:      alg,
+		issuer:   issuer,
+		audience: audience,
+	}, nil
+}
+
+// JWT 형식의 ID 토큰 검증기
+type JwtVerifier struct {
+	key crypto.PublicKey
+
+	// 공개 키로 검증할 수 있는 서명 알고리즘, 토큰 헤더의 alg와 같아야 합니다
+	alg string
+
+	// 허용하는 토큰 발급자(iss)와 대상(aud)
+	issuer   string
+	audience string
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Subject   string          `json:"sub"`
+	Issuer    string          `json:"iss"`
+	Audience  json.RawMessage `json:"aud"`
+	ExpiresAt *float64        `json:"exp"`
+	NotBefore *float64        `json:"nbf"`
+}
+
+func (v *JwtVerifier) Verify(token string, now time.Time) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", ErrIdTokenMalformed
+	}
+
+	var header jwtHeader
+	if err := decodeJwtSegment(parts[0], &header); err != nil {
+		return "", err
+	}
+	if header.Alg != v.alg {
+		return "", ErrIdTokenSignature
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", ErrIdTokenMalformed
+	}
+	if !v.verifySignature([]byte(parts[0]+"."+parts[1]), sig) {
+		return "", ErrIdTokenSignature
+	}
+
+	var claims jwtClaims
+	if err := decodeJwtSegment(parts[1], &claims); err != nil {
+		return "", err
+	}
+
+	if claims.Subject == "" {
+		return "", ErrIdTokenClaims
+	}
+	if claims.ExpiresAt == nil || now.After(unixTime(*claims.ExpiresAt).Add(idTokenLeeway)) {
+		return "", ErrIdTokenExpired
+	}
+	if claims.NotBefore != nil && now.Add(idTokenLeeway).Before(unixTime(*claims.NotBefore)) {
+		return "", ErrIdTokenClaims
+	}
+	// 검증기를 생성자 없이 만든 경우에도 발급자, 대상이 빈 토큰을 허용하지 않습니다
+	if v.issuer == "" || claims.Issuer != v.issuer {
+		return "", ErrIdTokenClaims
+	}
+	if v.audience == "" || !hasAudience(claims.Audience, v.audience) {
+		return "", ErrIdTokenClaims
+	}
+
+	return claims.Subject, nil
+}
+
+func (v *JwtVerifier) verifySignature(signed []byte, sig []byte) bool {
+	switch k := v.key.(type) {
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(signed)
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig) == nil
+
+	case *ecdsa.PublicKey:
+		// JWS ES256 서명은 r, s를 각각 32바이트로 이어 붙인 형식입니다
+		if len(sig) != 64 {
+			return false
+		}
+		digest := sha256.Sum256(signed)
+		r := new(big.Int).SetBytes(sig[:32])
+		s := new(big.Int).SetBytes(sig[32:])
+		return ecdsa.Verify(k, digest[:], r, s)
+
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, signed, sig)
+
+	default:
+		return false
+	}
+}
+
+func decodeJwtSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return ErrIdTokenMalformed
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return ErrIdTokenMalformed
+	}
+	return nil
+}
+
+func unixTime(sec float64) time.Time {
+	return time.Unix(int64(sec), 0)
+}
+
+// aud 클레임은 문자열 또는 문자열 배열입니다
+func hasAudience(raw json.RawMessage, audience string) bool {
+	if len(raw) == 0 {
+		return false
+	}
+
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return single == audience
+	}
+
+	var list []string
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return false
+	}
+	for _, aud := range list {
+		if aud == audience {
+			return true
+		}
+	}
+	return false
+}
+
+// PEM 형식의 공개 키(PUBLIC KEY) 또는 인증서(CERTIFICATE)에서 공개 키를 읽습니다
+func parsePublicKeyPem(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("pem block not found")
+	}
+
+	switch block.Type {
+	case "PUBLIC KEY":
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedPublicKey, block.Type)
+	}
+}
@@ -0,0 +1,249 @@
+package identity
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const (
+	testIssuer   = "https://accounts.example.com"
+	testAudience = "client-1"
+)
+
+// 테스트용 JWT를 서명합니다
+func signTestJwt(t *testing.T, alg string, key crypto.Signer, claims map[string]any) string {
+	t.Helper()
+
+	header, err := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	if err != nil {
+		t.Fatalf("failed to marshal header: %v", err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatalf("failed to marshal claims: %v", err)
+	}
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	var sig []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		digest := sha256.Sum256([]byte(signed))
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		digest := sha256.Sum256([]byte(signed))
+		r, s, signErr := ecdsa.Sign(rand.Reader, k, digest[:])
+		err = signErr
+		if err == nil {
+			sig = make([]byte, 64)
+			r.FillBytes(sig[:32])
+			s.FillBytes(sig[32:])
+		}
+	case ed25519.PrivateKey:
+		sig = ed25519.Sign(k, []byte(signed))
+	default:
+		t.Fatalf("unsupported key type: %T", key)
+	}
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func validClaims(now time.Time) map[string]any {
+	return map[string]any{
+		"sub": "user-1",
+		"iss": testIssuer,
+		"aud": testAudience,
+		"exp": now.Add(time.Hour).Unix(),
+	}
+}
+
+func TestJwtVerifierAlgorithms(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate rsa key: %v", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate ecdsa key: %v", err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate ed25519 key: %v", err)
+	}
+
+	tests := []struct {
+		alg string
+		key crypto.Signer
+	}{
+		{"RS256", rsaKey},
+		{"ES256", ecKey},
+		{"EdDSA", edKey},
+	}
+
+	now := time.Now()
+	for _, tt := range tests {
+		t.Run(tt.alg, func(t *testing.T) {
+			v, err := NewJwtVerifier(tt.key.Public(), testIssuer, testAudience)
+			if err != nil {
+				t.Fatalf("failed to create verifier: %v", err)
+			}
+
+			subject, err := v.Verify(signTestJwt(t, tt.alg, tt.key, validClaims(now)), now)
+			if err != nil {
+				t.Fatalf("failed to verify token: %v", err)
+			}
+			if subject != "user-1" {
+				t.Errorf("expected subject user-1, got %q", subject)
+			}
+		})
+	}
+}
+
+func TestJwtVerifierRejects(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	v, err := NewJwtVerifier(key.Public(), testIssuer, testAudience)
+	if err != nil {
+		t.Fatalf("failed to create verifier: %v", err)
+	}
+
+	now := time.Now()
+	with := func(name string, value any) map[string]any {
+		claims := validClaims(now)
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+		return claims
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		want  error
+	}{
+		{"wrong issuer", signTestJwt(t, "EdDSA", key, with("iss", "https://evil.example.com")), ErrIdTokenClaims},
+		{"missing issuer", signTestJwt(t, "EdDSA", key, with("iss", nil)), ErrIdTokenClaims},
+		{"wrong audience", signTestJwt(t, "EdDSA", key, with("aud", "client-2")), ErrIdTokenClaims},
+		{"missing audience", signTestJwt(t, "EdDSA", key, with("aud", nil)), ErrIdTokenClaims},
+		{"missing subject", signTestJwt(t, "EdDSA", key, with("sub", nil)), ErrIdTokenClaims},
+		{"not yet valid", signTestJwt(t, "EdDSA", key, with("nbf", now.Add(time.Hour).Unix())), ErrIdTokenClaims},
+		{"expired", signTestJwt(t, "EdDSA", key, with("exp", now.Add(-time.Hour).Unix())), ErrIdTokenExpired},
+		{"missing expiry", signTestJwt(t, "EdDSA", key, with("exp", nil)), ErrIdTokenExpired},
+		{"other key", signTestJwt(t, "EdDSA", otherKey, validClaims(now)), ErrIdTokenSignature},
+		{"algorithm mismatch", signTestJwt(t, "RS256", key, validClaims(now)), ErrIdTokenSignature},
+		{"malformed", "not-a-jwt", ErrIdTokenMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := v.Verify(tt.token, now); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestJwtVerifierAudienceList(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	v, err := NewJwtVerifier(key.Public(), testIssuer, testAudience)
+	if err != nil {
+		t.Fatalf("failed to create verifier: %v", err)
+	}
+
+	now := time.Now()
+	claims := validClaims(now)
+	claims["aud"] = []string{"client-2", testAudience}
+
+	if _, err := v.Verify(signTestJwt(t, "EdDSA", key, claims), now); err != nil {
+		t.Errorf("expected audience list to be accepted, got %v", err)
+	}
+}
+
+func TestNewJwtVerifierRequiresIssuerAndAudience(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	if _, err := NewJwtVerifier(key.Public(), "", testAudience); !errors.Is(err, ErrIdTokenIssuerIsEmpty) {
+		t.Errorf("expected issuer error, got %v", err)
+	}
+	if _, err := NewJwtVerifier(key.Public(), testIssuer, ""); !errors.Is(err, ErrIdTokenAudienceIsEmpty) {
+		t.Errorf("expected audience error, got %v", err)
+	}
+
+	// 생성자 없이 만든 검증기도 발급자, 대상 검사를 건너뛰지 않습니다
+	v := &JwtVerifier{key: key.Public(), alg: "EdDSA"}
+	now := time.Now()
+	if _, err := v.Verify(signTestJwt(t, "EdDSA", key, validClaims(now)), now); !errors.Is(err, ErrIdTokenClaims) {
+		t.Errorf("expected claims error, got %v", err)
+	}
+}
+
+func TestNewJwtVerifierUnsupportedKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	if _, err := NewJwtVerifier(key.Public(), testIssuer, testAudience); !errors.Is(err, ErrUnsupportedPublicKey) {
+		t.Errorf("expected unsupported key error, got %v", err)
+	}
+}
+
+func TestNewJwtVerifierFromFile(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "public.pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o644); err != nil {
+		t.Fatalf("failed to write public key: %v", err)
+	}
+
+	v, err := NewJwtVerifierFromFile(path, testIssuer, testAudience)
+	if err != nil {
+		t.Fatalf("failed to create verifier: %v", err)
+	}
+
+	now := time.Now()
+	if _, err := v.Verify(signTestJwt(t, "ES256", key, validClaims(now)), now); err != nil {
+		t.Errorf("failed to verify token: %v", err)
+	}
+}
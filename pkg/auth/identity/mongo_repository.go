@@ -0,0 +1,143 @@
+package identity
+
+import (
+	"MScannot206/shared"
+	"MScannot206/shared/entity"
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var ErrIdentityRepositoryIsNil = errors.New("identity repository is null")
+
+func NewIdentityRepository(
+	ctx context.Context,
+	client *mongo.Client,
+	dbName string,
+) (*IdentityRepository, error) {
+
+	if client == nil {
+		return nil, errors.New("mongo client is null")
+	}
+
+	repo := &IdentityRepository{
+		client:   client,
+		identity: client.Database(dbName).Collection(shared.UserIdentity),
+		user:     client.Database(dbName).Collection(shared.User),
+	}
+
+	if err := repo.ensureIndexes(ctx); err != nil {
+		return nil, err
+	}
+
+	return repo, nil
+}
+
+type IdentityRepository struct {
+	client   *mongo.Client
+	identity *mongo.Collection
+	user     *mongo.Collection
+}
+
+func (r *IdentityRepository) ensureIndexes(ctx context.Context) error {
+	indexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "uid", Value: 1},
+		},
+		Options: options.Index().
+			SetName("identity_uid_idx"),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	_, err := r.identity.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// 인증 정보 고유 ID로 인증 정보를 조회합니다. 없는 인증 정보는 결과에 포함되지 않습니다
+func (r *IdentityRepository) FindIdentities(ctx context.Context, ids []string) (map[string]*entity.UserIdentity, error) {
+	if len(ids) == 0 {
+		return map[string]*entity.UserIdentity{}, nil
+	}
+
+	filter := bson.M{
+		"_id": bson.M{"$in": ids},
+	}
+
+	cursor, err := r.identity.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var identities []*entity.UserIdentity
+	if err := cursor.All(ctx, &identities); err != nil {
+		return nil, err
+	}
+
+	ret := make(map[string]*entity.UserIdentity, len(identities))
+	for _, identity := range identities {
+		ret[identity.Id] = identity
+	}
+
+	return ret, nil
+}
+
+// 인증 정보 고유 ID로 인증 정보를 조회합니다. 없으면 nil을 반환합니다
+func (r *IdentityRepository) FindIdentity(ctx context.Context, id string) (*entity.UserIdentity, error) {
+	var identity entity.UserIdentity
+	err := r.identity.FindOne(ctx, bson.M{"_id": id}).Decode(&identity)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+// 새 인증 정보를 저장합니다
+// 같은 인증 정보가 이미 있으면 중복 키 오류를 그대로 반환합니다 (mongo.IsDuplicateKeyError)
+func (r *IdentityRepository) InsertIdentity(ctx context.Context, identity *entity.UserIdentity) error {
+	identity.CreatedAt = time.Now().UTC()
+
+	_, err := r.identity.InsertOne(ctx, identity)
+	return err
+}
+
+// 유저 고유 ID 중 인증 정보가 하나라도 연결된 유저 고유 ID를 조회합니다
+func (r *IdentityRepository) FindLinkedUids(ctx context.Context, uids []string) ([]string, error) {
+	return distinctStrings(ctx, r.identity, "uid", bson.M{
+		"uid": bson.M{"$in": uids},
+	})
+}
+
+// 유저 고유 ID 중 유저가 있는 유저 고유 ID를 조회합니다
+func (r *IdentityRepository) FindUserUids(ctx context.Context, uids []string) ([]string, error) {
+	return distinctStrings(ctx, r.user, "_id", bson.M{
+		"_id": bson.M{"$in": uids},
+	})
+}
+
+func distinctStrings(ctx context.Context, collection *mongo.Collection, field string, filter bson.M) ([]string, error) {
+	values, err := collection.Distinct(ctx, field, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]string, 0, len(values))
+	for _, v := range values {
+		if str, ok := v.(string); ok {
+			ret = append(ret, str)
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,137 @@
+package identity
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 비밀번호 해시 방식
+const (
+	PasswordHasherBcrypt   = "bcrypt"
+	PasswordHasherArgon2id = "argon2id"
+)
+
+// argon2id 기본 매개변수 (RFC 9106 권장값)
+const (
+	argon2idMemory  = 64 * 1024
+	argon2idTime    = 3
+	argon2idThreads = 4
+	argon2idKeyLen  = 32
+	argon2idSaltLen = 16
+)
+
+var ErrPasswordHasherIsNil = errors.New("password hasher is null")
+var ErrUnknownPasswordHasher = errors.New("unknown password hasher")
+var ErrUnknownPasswordHash = errors.New("unknown password hash format")
+
+// 비밀번호 해시 생성기
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+}
+
+// 이름으로 비밀번호 해시 생성기를 만듭니다. 비어 있으면 bcrypt를 사용합니다
+func NewPasswordHasher(name string) (PasswordHasher, error) {
+	switch name {
+	case "", PasswordHasherBcrypt:
+		return &BcryptHasher{cost: bcrypt.DefaultCost}, nil
+	case PasswordHasherArgon2id:
+		return &Argon2idHasher{
+			memory:  argon2idMemory,
+			time:    argon2idTime,
+			threads: argon2idThreads,
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrUnknownPasswordHasher, name)
+	}
+}
+
+// bcrypt 해시 생성기
+type BcryptHasher struct {
+	cost int
+}
+
+func (h *BcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// argon2id 해시 생성기
+// 해시는 PHC 문자열 형식($argon2id$v=19$m=...,t=...,p=...$salt$hash)으로 저장합니다
+type Argon2idHasher struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+}
+
+func (h *Argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, argon2idSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.time, h.memory, h.threads, argon2idKeyLen)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		h.memory, h.time, h.threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// 저장된 해시의 형식에 맞는 방식으로 비밀번호를 검증합니다
+func verifyPassword(hash string, password string) (bool, error) {
+	switch {
+	case strings.HasPrefix(hash, "$argon2id$"):
+		return verifyArgon2id(hash, password)
+	case strings.HasPrefix(hash, "$2a$"), strings.HasPrefix(hash, "$2b$"), strings.HasPrefix(hash, "$2y$"):
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		return err == nil, err
+	default:
+		return false, ErrUnknownPasswordHash
+	}
+}
+
+func verifyArgon2id(hash string, password string) (bool, error) {
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", salt, key
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return false, ErrUnknownPasswordHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false, ErrUnknownPasswordHash
+	}
+
+	var memory, time uint32
+	var threads uint8
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil {
+		return false, ErrUnknownPasswordHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, ErrUnknownPasswordHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return false, ErrUnknownPasswordHash
+	}
+
+	other := argon2.IDKey([]byte(password), salt, time, memory, threads, uint32(len(key)))
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
@@ -0,0 +1,110 @@
+package identity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPasswordHasherRoundTrip(t *testing.T) {
+	for _, name := range []string{PasswordHasherBcrypt, PasswordHasherArgon2id} {
+		t.Run(name, func(t *testing.T) {
+			hasher, err := NewPasswordHasher(name)
+			if err != nil {
+				t.Fatalf("failed to create hasher: %v", err)
+			}
+
+			hash, err := hasher.Hash("p@ssw0rd!")
+			if err != nil {
+				t.Fatalf("failed to hash password: %v", err)
+			}
+
+			ok, err := verifyPassword(hash, "p@ssw0rd!")
+			if err != nil || !ok {
+				t.Errorf("expected password to match, got ok=%v err=%v", ok, err)
+			}
+
+			ok, err = verifyPassword(hash, "wrong-password")
+			if err != nil || ok {
+				t.Errorf("expected password mismatch, got ok=%v err=%v", ok, err)
+			}
+		})
+	}
+}
+
+func TestArgon2idHashFormat(t *testing.T) {
+	hasher, err := NewPasswordHasher(PasswordHasherArgon2id)
+	if err != nil {
+		t.Fatalf("failed to create hasher: %v", err)
+	}
+
+	hash, err := hasher.Hash("p@ssw0rd!")
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+
+	if !strings.HasPrefix(hash, "$argon2id$v=19$m=65536,t=3,p=4$") {
+		t.Errorf("unexpected argon2id hash format: %v", hash)
+	}
+
+	// 솔트가 달라 같은 비밀번호도 다른 해시가 됩니다
+	other, err := hasher.Hash("p@ssw0rd!")
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+	if hash == other {
+		t.Errorf("expected different hashes for different salts")
+	}
+}
+
+func TestVerifyArgon2idParameters(t *testing.T) {
+	// 저장된 해시의 매개변수로 검증하므로 기본 매개변수가 바뀌어도 기존 해시를 검증할 수 있습니다
+	hasher := &Argon2idHasher{memory: 8 * 1024, time: 1, threads: 1}
+	hash, err := hasher.Hash("p@ssw0rd!")
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+
+	ok, err := verifyPassword(hash, "p@ssw0rd!")
+	if err != nil || !ok {
+		t.Errorf("expected password to match, got ok=%v err=%v", ok, err)
+	}
+}
+
+func TestVerifyPasswordLegacyBcrypt(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("p@ssw0rd!"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+
+	ok, err := verifyPassword(string(hash), "p@ssw0rd!")
+	if err != nil || !ok {
+		t.Errorf("expected password to match, got ok=%v err=%v", ok, err)
+	}
+}
+
+func TestVerifyPasswordMalformed(t *testing.T) {
+	tests := []string{
+		"",
+		"plain-text",
+		"$argon2id$v=19$m=65536,t=3,p=4$salt",
+		"$argon2id$v=18$m=65536,t=3,p=4$c2FsdA$a2V5",
+		"$argon2id$v=19$m=x,t=3,p=4$c2FsdA$a2V5",
+		"$argon2id$v=19$m=65536,t=3,p=4$!!!$a2V5",
+		"$argon2id$v=19$m=65536,t=3,p=4$c2FsdA$",
+	}
+
+	for _, hash := range tests {
+		if ok, err := verifyPassword(hash, "p@ssw0rd!"); ok || !errors.Is(err, ErrUnknownPasswordHash) {
+			t.Errorf("expected unknown hash error for %q, got ok=%v err=%v", hash, ok, err)
+		}
+	}
+}
+
+func TestNewPasswordHasherUnknown(t *testing.T) {
+	if _, err := NewPasswordHasher("md5"); !errors.Is(err, ErrUnknownPasswordHasher) {
+		t.Errorf("expected unknown hasher error, got %v", err)
+	}
+}
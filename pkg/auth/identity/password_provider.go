@@ -0,0 +1,101 @@
+package identity
+
+import (
+	"MScannot206/shared/entity"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// 아이디 길이 제한
+const (
+	MinUsernameLength = 4
+	MaxUsernameLength = 32
+)
+
+// 비밀번호 길이 제한 (bcrypt는 72바이트까지만 사용합니다)
+const (
+	MinPasswordLength = 8
+	MaxPasswordLength = 72
+)
+
+func NewPasswordProvider(hasher PasswordHasher) (*PasswordProvider, error) {
+	if hasher == nil {
+		return nil, ErrPasswordHasherIsNil
+	}
+
+	return &PasswordProvider{
+		hasher: hasher,
+	}, nil
+}
+
+// 아이디, 비밀번호 인증 수단
+// 아이디는 대소문자를 구분하지 않으며, 비밀번호는 hasher로 해시하여 저장합니다
+// 저장된 해시는 접두사로 해시 방식을 구분하므로 해시 방식을 바꿔도 기존 계정으로 로그인할 수 있습니다
+type PasswordProvider struct {
+	hasher PasswordHasher
+}
+
+func (p *PasswordProvider) Name() string {
+	return ProviderPassword
+}
+
+func (p *PasswordProvider) Subject(cred *Credential) (string, string) {
+	if !isValidUsername(cred.Username) {
+		return "", IDENTITY_USERNAME_INVALID_ERROR
+	}
+	if len(cred.Password) < MinPasswordLength || len(cred.Password) > MaxPasswordLength {
+		return "", IDENTITY_PASSWORD_INVALID_ERROR
+	}
+	return strings.ToLower(cred.Username), ""
+}
+
+func (p *PasswordProvider) Verify(cred *Credential, identity *entity.UserIdentity) string {
+	if cred.Register {
+		return IDENTITY_USERNAME_ALREADY_EXISTS_ERROR
+	}
+
+	ok, err := verifyPassword(identity.PasswordHash, cred.Password)
+	if err != nil {
+		log.Err(err).Msgf("비밀번호 해시 검증 오류: %v", identity.Id)
+		return IDENTITY_PASSWORD_MISMATCH_ERROR
+	}
+	if !ok {
+		return IDENTITY_PASSWORD_MISMATCH_ERROR
+	}
+	return ""
+}
+
+func (p *PasswordProvider) Register(cred *Credential, identity *entity.UserIdentity) string {
+	if !cred.Register {
+		return IDENTITY_ACCOUNT_NOT_FOUND_ERROR
+	}
+
+	hash, err := p.hasher.Hash(cred.Password)
+	if err != nil {
+		log.Err(err).Msg("비밀번호 해시 생성 오류")
+		return IDENTITY_DB_WRITE_ERROR
+	}
+
+	identity.PasswordHash = hash
+	return ""
+}
+
+// 아이디는 영문, 숫자, _ 로만 구성합니다
+func isValidUsername(username string) bool {
+	if len(username) < MinUsernameLength || len(username) > MaxUsernameLength {
+		return false
+	}
+
+	for _, c := range username {
+		switch {
+		case c >= 'a' && c <= 'z':
+		case c >= 'A' && c <= 'Z':
+		case c >= '0' && c <= '9':
+		case c == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package identity
+
+import (
+	"MScannot206/shared/entity"
+	"errors"
+)
+
+// 인증 수단 이름
+const (
+	// 아이디, 비밀번호
+	ProviderPassword = "password"
+
+	// 기기 ID (게스트)
+	ProviderDevice = "device"
+
+	// 이전 버전의 유저 고유 ID, 다른 인증 수단을 연결하기 전까지 전환 기간에만 사용합니다
+	ProviderLegacy = "legacy"
+)
+
+var ErrProviderIsNil = errors.New("identity provider is null")
+var ErrProviderAlreadyRegistered = errors.New("identity provider is already registered")
+
+// 로그인 요청의 인증 정보
+// 인증 수단마다 사용하는 필드가 다릅니다
+type Credential struct {
+	// 인증 수단 이름
+	Provider string
+
+	// 아이디 (password)
+	Username string
+
+	// 비밀번호 (password)
+	Password string
+
+	// 계정이 없으면 새로 생성할지 여부 (password)
+	Register bool
+
+	// 기기 ID (device)
+	DeviceId string
+
+	// 외부 ID 토큰 (외부 ID 토큰 인증 수단)
+	IdToken string
+
+	// 이전 버전의 유저 고유 ID (legacy)
+	Uid string
+}
+
+// 인증 수단
+// 인증 정보에서 계정 ID를 구하고 저장된 계정과 비교하여 검증합니다
+// 각 함수는 실패 시 오류 코드를, 성공 시 빈 문자열을 반환합니다
+type Provider interface {
+	// 인증 수단 이름, 인증 정보 고유 ID의 접두사로도 사용합니다
+	Name() string
+
+	// 인증 정보에서 인증 수단 내 계정 ID를 구합니다
+	Subject(cred *Credential) (string, string)
+
+	// 저장된 계정으로 인증 정보를 검증합니다
+	Verify(cred *Credential, identity *entity.UserIdentity) string
+
+	// 계정이 없을 때 새 계정 정보를 채웁니다. 계정을 새로 만들 수 없으면 오류 코드를 반환합니다
+	Register(cred *Credential, identity *entity.UserIdentity) string
+}
+
+// 인증 정보 고유 ID를 만듭니다
+func IdentityId(provider string, subject string) string {
+	return provider + ":" + subject
+}
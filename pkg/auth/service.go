@@ -1,22 +1,80 @@
 package auth
 
 import (
+	"MScannot206/pkg/auth/identity"
 	"MScannot206/pkg/auth/session"
 	"MScannot206/shared/entity"
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"time"
 
 	"github.com/rs/zerolog/log"
 )
 
-func NewAuthService() (*AuthService, error) {
-	return &AuthService{}, nil
+// 액세스 토큰 기본 유효 시간(초)
+const DefaultAccessTokenTtlSeconds = 900
+
+// 리프레시 토큰 기본 유효 시간(시간)
+const DefaultRefreshTokenTtlHours = 168
+
+// 인증 서비스 설정 (0이나 빈 값은 기본값을 사용합니다)
+type AuthServiceConfig struct {
+	// 액세스 토큰 유효 시간(초)
+	AccessTokenTtlSeconds int
+
+	// 리프레시 토큰 유효 시간(시간), 리프레시 토큰을 사용할 때마다 연장됩니다
+	RefreshTokenTtlHours int
+
+	// 액세스 토큰 서명 키 목록, 첫 번째 키로 서명하고 나머지는 검증에만 사용합니다
+	// 서버를 재시작하거나 여러 서버를 띄워도 같은 키로 검증해야 하므로 반드시 설정해야 합니다
+	TokenKeys []*session.TokenKey
+}
+
+func NewAuthService(cfg AuthServiceConfig) (*AuthService, error) {
+	if cfg.AccessTokenTtlSeconds <= 0 {
+		cfg.AccessTokenTtlSeconds = DefaultAccessTokenTtlSeconds
+	}
+
+	if cfg.RefreshTokenTtlHours <= 0 {
+		cfg.RefreshTokenTtlHours = DefaultRefreshTokenTtlHours
+	}
+
+	if len(cfg.TokenKeys) == 0 {
+		return nil, session.ErrTokenKeyIsEmpty
+	}
+
+	signer, err := session.NewTokenSigner(cfg.TokenKeys, time.Duration(cfg.AccessTokenTtlSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthService{
+		signer:          signer,
+		refreshTokenTtl: time.Duration(cfg.RefreshTokenTtlHours) * time.Hour,
+
+		providers: make(map[string]identity.Provider),
+	}, nil
 }
 
 type AuthService struct {
-	sessionRepo *session.SessionRepository
+	sessionRepo  *session.SessionRepository
+	identityRepo *identity.IdentityRepository
+
+	// 액세스 토큰 서명기
+	signer *session.TokenSigner
+
+	// 리프레시 토큰 유효 시간
+	refreshTokenTtl time.Duration
+
+	// 인증 수단 이름별 인증 수단
+	providers map[string]identity.Provider
+
+	// 이전 버전의 유저 고유 ID 로그인 허용 여부
+	legacyUidLogin bool
 }
 
 func (s *AuthService) Start(ctx context.Context) error {
@@ -29,6 +87,7 @@ func (s *AuthService) Stop(ctx context.Context) error {
 
 func (s *AuthService) SetRepositories(
 	sessionRepo *session.SessionRepository,
+	identityRepo *identity.IdentityRepository,
 ) error {
 	var errs error
 
@@ -37,6 +96,11 @@ func (s *AuthService) SetRepositories(
 		errs = errors.Join(errs, session.ErrSessionRepositoryIsNil)
 	}
 
+	s.identityRepo = identityRepo
+	if identityRepo == nil {
+		errs = errors.Join(errs, identity.ErrIdentityRepositoryIsNil)
+	}
+
 	return errs
 }
 
@@ -49,22 +113,48 @@ func (s *AuthService) generateToken() (string, error) {
 	return base64.URLEncoding.EncodeToString(b), nil
 }
 
+// 리프레시 토큰은 원문 대신 SHA-256 해시로 저장합니다
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// 유저의 새 액세스 토큰과 리프레시 토큰을 발급합니다
+func (s *AuthService) issueSession(uid string) (*entity.UserSession, error) {
+	now := time.Now().UTC()
+
+	token, tokenExpireAt, err := s.signer.Sign(uid, now)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := s.generateToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.UserSession{
+		Uid:              uid,
+		Token:            token,
+		TokenExpireAt:    tokenExpireAt,
+		RefreshToken:     refreshToken,
+		RefreshTokenHash: hashRefreshToken(refreshToken),
+		ExpireAt:         now.Add(s.refreshTokenTtl),
+	}, nil
+}
+
 func (s *AuthService) CreateUserSessions(ctx context.Context, user []*entity.User) ([]*entity.UserSession, []*entity.User, error) {
 	sessions := make([]*entity.UserSession, 0, len(user))
 	failureUsers := make([]*entity.User, 0)
 
 	for _, u := range user {
-		token, err := s.generateToken()
+		session, err := s.issueSession(u.Uid)
 		if err != nil {
-			log.Warn().Err(err)
+			log.Warn().Err(err).Msgf("세션 토큰 발급 실패: %v", u.Uid)
+			failureUsers = append(failureUsers, u)
 			continue
 		}
 
-		session := &entity.UserSession{
-			Uid:   u.Uid,
-			Token: token,
-		}
-
 		sessions = append(sessions, session)
 	}
 
@@ -76,6 +166,70 @@ func (s *AuthService) CreateUserSessions(ctx context.Context, user []*entity.Use
 	return sessions, failureUsers, nil
 }
 
+// 리프레시 토큰으로 새 액세스 토큰과 리프레시 토큰을 발급합니다
+// 사용한 리프레시 토큰은 새 리프레시 토큰으로 교체되어 다시 사용할 수 없습니다
+// sessions에는 유저 고유 ID와 리프레시 토큰을 채워 전달하며, 실패한 유저는 유저 고유 ID별 오류 코드로 반환합니다
+func (s *AuthService) RefreshUserSessions(ctx context.Context, sessions []*entity.UserSession) ([]*entity.UserSession, map[string]string, error) {
+	refreshedSessions := make([]*entity.UserSession, 0, len(sessions))
+	failureUids := make(map[string]string)
+	requestedUids := make(map[string]struct{}, len(sessions))
+
+	for _, req := range sessions {
+		// 같은 유저의 중복 요청은 재사용으로 감지되어 세션이 제거되므로 처음 요청만 처리합니다
+		if _, ok := requestedUids[req.Uid]; ok {
+			continue
+		}
+		requestedUids[req.Uid] = struct{}{}
+
+		if req.RefreshToken == "" {
+			failureUids[req.Uid] = session.SESSION_REFRESH_TOKEN_INVALID_ERROR
+			continue
+		}
+
+		newSession, err := s.issueSession(req.Uid)
+		if err != nil {
+			log.Warn().Err(err).Msgf("세션 토큰 발급 실패: %v", req.Uid)
+			failureUids[req.Uid] = session.SESSION_DB_WRITE_ERROR
+			continue
+		}
+
+		errCode, err := s.sessionRepo.RotateRefreshToken(ctx, newSession, hashRefreshToken(req.RefreshToken))
+		if err != nil {
+			log.Err(err).Msgf("리프레시 토큰 교체 실패: %v", req.Uid)
+			failureUids[req.Uid] = session.SESSION_DB_WRITE_ERROR
+			continue
+		}
+		if errCode != "" {
+			failureUids[req.Uid] = errCode
+			continue
+		}
+
+		refreshedSessions = append(refreshedSessions, newSession)
+	}
+
+	return refreshedSessions, failureUids, nil
+}
+
+// 액세스 토큰의 서명과 만료 시각, 유저 고유 ID를 검증합니다. DB는 조회하지 않습니다
+// 리프레시 토큰 재사용으로 세션이 제거된 유저의 액세스 토큰도 만료될 때까지 (최대 AccessTokenTtlSeconds) 통과합니다
 func (s *AuthService) ValidateUserSessions(ctx context.Context, sessions []*entity.UserSession) ([]string, []string, error) {
-	return s.sessionRepo.ValidateUserSessions(ctx, sessions)
+	if len(sessions) == 0 {
+		return []string{}, []string{}, nil
+	}
+
+	sessionCount := len(sessions)
+	validUids := make([]string, 0, sessionCount)
+	invalidUids := make([]string, 0, (sessionCount / 2))
+
+	now := time.Now()
+	for _, session := range sessions {
+		uid, err := s.signer.Verify(session.Token, now)
+		if err == nil && uid == session.Uid {
+			validUids = append(validUids, session.Uid)
+		} else {
+			invalidUids = append(invalidUids, session.Uid)
+		}
+	}
+
+	return validUids, invalidUids, nil
 }
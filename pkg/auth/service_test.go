@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"MScannot206/pkg/auth/session"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewAuthServiceRequiresTokenKeys(t *testing.T) {
+	if _, err := NewAuthService(AuthServiceConfig{}); !errors.Is(err, session.ErrTokenKeyIsEmpty) {
+		t.Errorf("expected token key error, got %v", err)
+	}
+
+	s, err := NewAuthService(AuthServiceConfig{
+		TokenKeys: []*session.TokenKey{
+			{Id: "k1", Secret: []byte(strings.Repeat("k", session.MinTokenKeySecretLength))},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create auth service: %v", err)
+	}
+
+	token, _, err := s.signer.Sign("user1", time.Now())
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	if !strings.HasPrefix(token, "k1.") {
+		t.Errorf("expected token to be signed with configured key, got %v", token)
+	}
+}
@@ -3,7 +3,13 @@ package session
 import "MScannot206/shared"
 
 const SESSION_TOKEN_INVALID_ERROR = "SESSION_TOKEN_INVALID_ERROR"
+const SESSION_REFRESH_TOKEN_INVALID_ERROR = "SESSION_REFRESH_TOKEN_INVALID_ERROR"
+const SESSION_REFRESH_TOKEN_REUSED_ERROR = "SESSION_REFRESH_TOKEN_REUSED_ERROR"
+const SESSION_DB_WRITE_ERROR = "SESSION_DB_WRITE_ERROR"
 
 func init() {
 	shared.RegisterError(SESSION_TOKEN_INVALID_ERROR, "세션 토큰이 유효하지 않습니다.")
+	shared.RegisterError(SESSION_REFRESH_TOKEN_INVALID_ERROR, "리프레시 토큰이 유효하지 않거나 만료되었습니다.")
+	shared.RegisterError(SESSION_REFRESH_TOKEN_REUSED_ERROR, "이미 사용한 리프레시 토큰입니다. 다시 로그인해야 합니다.")
+	shared.RegisterError(SESSION_DB_WRITE_ERROR, "세션 저장 중 데이터베이스 쓰기 오류가 발생하였습니다.")
 }
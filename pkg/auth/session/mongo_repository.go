@@ -13,8 +13,14 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-// 7주일
-const expireAfterSeconds = 3600 * 24 * 7
+// 이전 버전의 세션 TTL 인덱스 이름 (updated_at 기준 7일)
+const legacySessionTtlIndexName = "session_ttl_idx"
+
+// 삭제할 인덱스가 없는 경우의 서버 오류 코드 (NamespaceNotFound, IndexNotFound)
+const (
+	errorCodeNamespaceNotFound = 26
+	errorCodeIndexNotFound     = 27
+)
 
 var ErrSessionRepositoryIsNil = errors.New("session repository is null")
 
@@ -46,26 +52,49 @@ type SessionRepository struct {
 }
 
 func (r *SessionRepository) ensureIndexes(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// 세션 만료 기준이 updated_at에서 expire_at으로 바뀌었으므로 이전 TTL 인덱스를 제거합니다
+	if _, err := r.session.Indexes().DropOne(ctx, legacySessionTtlIndexName); err != nil && !isIndexNotFoundError(err) {
+		return err
+	}
+
 	indexModel := mongo.IndexModel{
 		Keys: bson.D{
-			{Key: "updated_at", Value: 1},
+			{Key: "expire_at", Value: 1},
 		},
 		Options: options.Index().
-			SetExpireAfterSeconds(expireAfterSeconds).
-			SetName("session_ttl_idx"),
+			SetExpireAfterSeconds(0).
+			SetName("session_expire_idx"),
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
-	defer cancel()
-
 	_, err := r.session.Indexes().CreateOne(ctx, indexModel)
 	if err != nil {
 		return err
 	}
 
+	// 리프레시 토큰이 없는 이전 버전의 세션은 갱신할 수 없고 만료되지도 않으므로 제거합니다
+	if _, err := r.session.DeleteMany(ctx, bson.D{
+		{Key: "refresh_token_hash", Value: bson.D{
+			{Key: "$exists", Value: false},
+		}},
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+func isIndexNotFoundError(err error) bool {
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) {
+		return serverErr.HasErrorCode(errorCodeNamespaceNotFound) || serverErr.HasErrorCode(errorCodeIndexNotFound)
+	}
+	return false
+}
+
+// 유저 세션을 저장합니다. 유저의 기존 세션은 새 세션으로 교체되어 기존 리프레시 토큰은 사용할 수 없습니다
 func (r *SessionRepository) SaveUserSessions(ctx context.Context, sessions []*entity.UserSession) error {
 	if len(sessions) == 0 {
 		return nil
@@ -81,7 +110,14 @@ func (r *SessionRepository) SaveUserSessions(ctx context.Context, sessions []*en
 		}
 
 		update := bson.D{
-			{Key: "$set", Value: session},
+			{Key: "$set", Value: bson.D{
+				{Key: "refresh_token_hash", Value: session.RefreshTokenHash},
+				{Key: "expire_at", Value: session.ExpireAt},
+				{Key: "updated_at", Value: session.UpdatedAt},
+			}},
+			{Key: "$unset", Value: bson.D{
+				{Key: "prev_refresh_token_hash", Value: ""},
+			}},
 		}
 
 		models[i] = mongo.NewUpdateOneModel().
@@ -105,51 +141,50 @@ func (r *SessionRepository) SaveUserSessions(ctx context.Context, sessions []*en
 	return nil
 }
 
-func (r *SessionRepository) ValidateUserSessions(ctx context.Context, sessions []*entity.UserSession) ([]string, []string, error) {
-	if len(sessions) == 0 {
-		return []string{}, []string{}, nil
+// 유저 세션의 리프레시 토큰을 새 리프레시 토큰으로 교체하고 세션 만료 시각을 연장합니다
+// 실패 시 오류 코드를 반환하며, 이미 교체된 리프레시 토큰을 다시 사용한 경우 토큰 탈취로 간주하여 세션을 제거합니다
+// 세션을 제거해도 이미 발급된 액세스 토큰은 DB 조회 없이 검증하므로 만료될 때까지 (최대 AccessTokenTtlSeconds) 계속 유효합니다
+func (r *SessionRepository) RotateRefreshToken(ctx context.Context, session *entity.UserSession, oldHash string) (string, error) {
+	now := time.Now().UTC()
+	session.UpdatedAt = now
+
+	filter := bson.D{
+		{Key: "_id", Value: session.Uid},
+		{Key: "refresh_token_hash", Value: oldHash},
+		{Key: "expire_at", Value: bson.D{
+			{Key: "$gt", Value: now},
+		}},
 	}
 
-	sessionCount := len(sessions)
-	validUids := make([]string, 0, sessionCount)
-	invalidUids := make([]string, 0, (sessionCount / 2))
-
-	targetUids := make([]string, 0, sessionCount)
-	inputSessionMap := make(map[string]string, sessionCount)
-
-	for _, s := range sessions {
-		targetUids = append(targetUids, s.Uid)
-		inputSessionMap[s.Uid] = s.Token
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "refresh_token_hash", Value: session.RefreshTokenHash},
+			{Key: "prev_refresh_token_hash", Value: oldHash},
+			{Key: "expire_at", Value: session.ExpireAt},
+			{Key: "updated_at", Value: now},
+		}},
 	}
 
-	filter := bson.M{
-		"_id": bson.M{"$in": targetUids},
-	}
-
-	cursor, err := r.session.Find(ctx, filter)
+	result, err := r.session.UpdateOne(ctx, filter, update)
 	if err != nil {
-		return []string{}, []string{}, err
+		return "", err
 	}
-	defer cursor.Close(ctx)
-
-	var dbSessions []*entity.UserSession
-	if err := cursor.All(ctx, &dbSessions); err != nil {
-		return []string{}, []string{}, err
+	if result.MatchedCount > 0 {
+		return "", nil
 	}
 
-	dbSessionMap := make(map[string]*entity.UserSession, len(dbSessions))
-	for _, s := range dbSessions {
-		dbSessionMap[s.Uid] = s
+	// 이미 교체된 리프레시 토큰인지 확인
+	deleted, err := r.session.DeleteOne(ctx, bson.D{
+		{Key: "_id", Value: session.Uid},
+		{Key: "prev_refresh_token_hash", Value: oldHash},
+	})
+	if err != nil {
+		return "", err
 	}
-
-	for _, uid := range targetUids {
-		s, ok := dbSessionMap[uid]
-		if ok && s.Token == inputSessionMap[uid] {
-			validUids = append(validUids, uid)
-		} else {
-			invalidUids = append(invalidUids, uid)
-		}
+	if deleted.DeletedCount > 0 {
+		log.Warn().Msgf("이미 사용한 리프레시 토큰 재사용으로 세션 제거: %v", session.Uid)
+		return SESSION_REFRESH_TOKEN_REUSED_ERROR, nil
 	}
 
-	return validUids, invalidUids, nil
+	return SESSION_REFRESH_TOKEN_INVALID_ERROR, nil
 }
@@ -0,0 +1,163 @@
+package session
+
+import (
+	"MScannot206/shared/entity"
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 설정되어 있으면 MongoDB로 세션 저장소 테스트를 실행합니다
+const testMongoUriEnv = "MSCANNOT_TEST_MONGO_URI"
+
+func newTestSession(uid string, refreshTokenHash string) *entity.UserSession {
+	return &entity.UserSession{
+		Uid:              uid,
+		RefreshTokenHash: refreshTokenHash,
+		ExpireAt:         time.Now().UTC().Add(time.Hour),
+	}
+}
+
+func TestRotateRefreshTokenMongo(t *testing.T) {
+	uri := os.Getenv(testMongoUriEnv)
+	if uri == "" {
+		t.Skipf("%v is not set", testMongoUriEnv)
+	}
+
+	ctx := context.Background()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		t.Fatalf("failed to connect mongo: %v", err)
+	}
+	t.Cleanup(func() {
+		client.Disconnect(context.Background())
+	})
+
+	newRepo := func(t *testing.T) *SessionRepository {
+		dbName := "session_test_" + primitive.NewObjectID().Hex()
+		t.Cleanup(func() {
+			client.Database(dbName).Drop(context.Background())
+		})
+
+		repo, err := NewSessionRepository(ctx, client, dbName)
+		if err != nil {
+			t.Fatalf("failed to create session repository: %v", err)
+		}
+		return repo
+	}
+
+	t.Run("rotate", func(t *testing.T) {
+		repo := newRepo(t)
+		if err := repo.SaveUserSessions(ctx, []*entity.UserSession{newTestSession("user1", "hash1")}); err != nil {
+			t.Fatalf("failed to save session: %v", err)
+		}
+
+		errCode, err := repo.RotateRefreshToken(ctx, newTestSession("user1", "hash2"), "hash1")
+		if err != nil || errCode != "" {
+			t.Fatalf("expected rotation to succeed, got errCode=%q err=%v", errCode, err)
+		}
+
+		// 교체한 리프레시 토큰으로 다시 교체할 수 있습니다
+		errCode, err = repo.RotateRefreshToken(ctx, newTestSession("user1", "hash3"), "hash2")
+		if err != nil || errCode != "" {
+			t.Fatalf("expected second rotation to succeed, got errCode=%q err=%v", errCode, err)
+		}
+	})
+
+	t.Run("reuse revokes session", func(t *testing.T) {
+		repo := newRepo(t)
+		if err := repo.SaveUserSessions(ctx, []*entity.UserSession{newTestSession("user1", "hash1")}); err != nil {
+			t.Fatalf("failed to save session: %v", err)
+		}
+
+		if errCode, err := repo.RotateRefreshToken(ctx, newTestSession("user1", "hash2"), "hash1"); err != nil || errCode != "" {
+			t.Fatalf("expected rotation to succeed, got errCode=%q err=%v", errCode, err)
+		}
+
+		// 이미 교체된 리프레시 토큰을 다시 사용하면 세션을 제거합니다
+		errCode, err := repo.RotateRefreshToken(ctx, newTestSession("user1", "hash3"), "hash1")
+		if err != nil {
+			t.Fatalf("failed to rotate: %v", err)
+		}
+		if errCode != SESSION_REFRESH_TOKEN_REUSED_ERROR {
+			t.Fatalf("expected reused error, got %q", errCode)
+		}
+
+		// 세션이 제거되었으므로 정상적으로 교체받은 리프레시 토큰도 사용할 수 없습니다
+		errCode, err = repo.RotateRefreshToken(ctx, newTestSession("user1", "hash4"), "hash2")
+		if err != nil {
+			t.Fatalf("failed to rotate: %v", err)
+		}
+		if errCode != SESSION_REFRESH_TOKEN_INVALID_ERROR {
+			t.Errorf("expected invalid error after revoke, got %q", errCode)
+		}
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		repo := newRepo(t)
+		if err := repo.SaveUserSessions(ctx, []*entity.UserSession{newTestSession("user1", "hash1")}); err != nil {
+			t.Fatalf("failed to save session: %v", err)
+		}
+
+		errCode, err := repo.RotateRefreshToken(ctx, newTestSession("user1", "hash2"), "other")
+		if err != nil {
+			t.Fatalf("failed to rotate: %v", err)
+		}
+		if errCode != SESSION_REFRESH_TOKEN_INVALID_ERROR {
+			t.Errorf("expected invalid error, got %q", errCode)
+		}
+
+		// 알 수 없는 토큰은 세션을 제거하지 않습니다
+		if errCode, err := repo.RotateRefreshToken(ctx, newTestSession("user1", "hash2"), "hash1"); err != nil || errCode != "" {
+			t.Errorf("expected rotation to succeed, got errCode=%q err=%v", errCode, err)
+		}
+	})
+
+	t.Run("expired session", func(t *testing.T) {
+		repo := newRepo(t)
+		expired := newTestSession("user1", "hash1")
+		expired.ExpireAt = time.Now().UTC().Add(-time.Minute)
+		if err := repo.SaveUserSessions(ctx, []*entity.UserSession{expired}); err != nil {
+			t.Fatalf("failed to save session: %v", err)
+		}
+
+		errCode, err := repo.RotateRefreshToken(ctx, newTestSession("user1", "hash2"), "hash1")
+		if err != nil {
+			t.Fatalf("failed to rotate: %v", err)
+		}
+		if errCode != SESSION_REFRESH_TOKEN_INVALID_ERROR {
+			t.Errorf("expected invalid error, got %q", errCode)
+		}
+	})
+
+	t.Run("login resets previous token", func(t *testing.T) {
+		repo := newRepo(t)
+		if err := repo.SaveUserSessions(ctx, []*entity.UserSession{newTestSession("user1", "hash1")}); err != nil {
+			t.Fatalf("failed to save session: %v", err)
+		}
+		if errCode, err := repo.RotateRefreshToken(ctx, newTestSession("user1", "hash2"), "hash1"); err != nil || errCode != "" {
+			t.Fatalf("expected rotation to succeed, got errCode=%q err=%v", errCode, err)
+		}
+
+		// 다시 로그인하면 이전 리프레시 토큰은 재사용이 아닌 유효하지 않은 토큰으로 처리합니다
+		if err := repo.SaveUserSessions(ctx, []*entity.UserSession{newTestSession("user1", "hash3")}); err != nil {
+			t.Fatalf("failed to save session: %v", err)
+		}
+
+		errCode, err := repo.RotateRefreshToken(ctx, newTestSession("user1", "hash4"), "hash1")
+		if err != nil {
+			t.Fatalf("failed to rotate: %v", err)
+		}
+		if errCode != SESSION_REFRESH_TOKEN_INVALID_ERROR {
+			t.Errorf("expected invalid error, got %q", errCode)
+		}
+		if errCode, err := repo.RotateRefreshToken(ctx, newTestSession("user1", "hash4"), "hash3"); err != nil || errCode != "" {
+			t.Errorf("expected rotation to succeed, got errCode=%q err=%v", errCode, err)
+		}
+	})
+}
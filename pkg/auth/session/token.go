@@ -0,0 +1,141 @@
+package session
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// 서명 키 최소 길이 (HMAC-SHA256)
+const MinTokenKeySecretLength = 32
+
+var ErrTokenKeyIsEmpty = errors.New("token key is empty")
+var ErrTokenMalformed = errors.New("access token is malformed")
+var ErrTokenUnknownKey = errors.New("access token key is unknown")
+var ErrTokenSignature = errors.New("access token signature is invalid")
+var ErrTokenExpired = errors.New("access token is expired")
+
+// 액세스 토큰 서명 키
+type TokenKey struct {
+	// 키 ID, 토큰에 함께 기록하여 검증할 키를 찾습니다
+	Id string
+
+	// HMAC 서명 비밀 값
+	Secret []byte
+}
+
+// 액세스 토큰 내용
+type tokenClaims struct {
+	Uid       string `json:"uid"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// 액세스 토큰 서명기를 만듭니다
+// 첫 번째 키로 서명하고, 나머지 키는 검증에만 사용하므로 키를 교체할 때는 새 키를 앞에 추가하고
+// 기존 키는 액세스 토큰 유효 시간이 지난 뒤 제거합니다
+func NewTokenSigner(keys []*TokenKey, ttl time.Duration) (*TokenSigner, error) {
+	if len(keys) == 0 {
+		return nil, ErrTokenKeyIsEmpty
+	}
+	if ttl <= 0 {
+		return nil, errors.New("access token ttl must be positive")
+	}
+
+	secrets := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		if key == nil || key.Id == "" || strings.Contains(key.Id, ".") {
+			return nil, fmt.Errorf("invalid token key id: %v", key)
+		}
+		if len(key.Secret) < MinTokenKeySecretLength {
+			return nil, fmt.Errorf("token key secret is too short: %v", key.Id)
+		}
+		if _, ok := secrets[key.Id]; ok {
+			return nil, fmt.Errorf("duplicate token key id: %v", key.Id)
+		}
+		secrets[key.Id] = key.Secret
+	}
+
+	return &TokenSigner{
+		signingKey: keys[0],
+		secrets:    secrets,
+		ttl:        ttl,
+	}, nil
+}
+
+// HMAC으로 서명한 액세스 토큰 서명기
+// 토큰은 "<키 ID>.<내용>.<서명>" 형식이며, 내용에 유저 고유 ID와 만료 시각을 담아 DB 조회 없이 검증합니다
+type TokenSigner struct {
+	signingKey *TokenKey
+
+	// 키 ID별 검증 비밀 값
+	secrets map[string][]byte
+
+	// 액세스 토큰 유효 시간
+	ttl time.Duration
+}
+
+// 유저의 액세스 토큰을 발급하고 만료 시각을 함께 반환합니다
+func (s *TokenSigner) Sign(uid string, now time.Time) (string, time.Time, error) {
+	expireAt := now.Add(s.ttl).Truncate(time.Second)
+
+	payload, err := json.Marshal(&tokenClaims{
+		Uid:       uid,
+		ExpiresAt: expireAt.Unix(),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	signed := s.signingKey.Id + "." + base64.RawURLEncoding.EncodeToString(payload)
+	sig := signToken(s.signingKey.Secret, signed)
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig), expireAt, nil
+}
+
+// 액세스 토큰을 검증하고 토큰의 유저 고유 ID를 반환합니다
+func (s *TokenSigner) Verify(token string, now time.Time) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", ErrTokenMalformed
+	}
+
+	secret, ok := s.secrets[parts[0]]
+	if !ok {
+		return "", ErrTokenUnknownKey
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", ErrTokenMalformed
+	}
+	if !hmac.Equal(sig, signToken(secret, parts[0]+"."+parts[1])) {
+		return "", ErrTokenSignature
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", ErrTokenMalformed
+	}
+
+	var claims tokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", ErrTokenMalformed
+	}
+
+	if !now.Before(time.Unix(claims.ExpiresAt, 0)) {
+		return "", ErrTokenExpired
+	}
+
+	return claims.Uid, nil
+}
+
+func signToken(secret []byte, signed string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signed))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,165 @@
+package session
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testTokenKey(id string) *TokenKey {
+	return &TokenKey{
+		Id:     id,
+		Secret: []byte(strings.Repeat(id, MinTokenKeySecretLength)),
+	}
+}
+
+func newTestTokenSigner(t *testing.T, keys ...*TokenKey) *TokenSigner {
+	t.Helper()
+
+	signer, err := NewTokenSigner(keys, 15*time.Minute)
+	if err != nil {
+		t.Fatalf("failed to create token signer: %v", err)
+	}
+	return signer
+}
+
+func TestTokenSignerSignVerify(t *testing.T) {
+	signer := newTestTokenSigner(t, testTokenKey("k1"))
+	now := time.Now()
+
+	token, expireAt, err := signer.Sign("user1", now)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	if !strings.HasPrefix(token, "k1.") {
+		t.Errorf("expected token to start with key id, got %v", token)
+	}
+	if want := now.Add(15 * time.Minute).Truncate(time.Second); !expireAt.Equal(want) {
+		t.Errorf("expected expire at %v, got %v", want, expireAt)
+	}
+
+	uid, err := signer.Verify(token, now)
+	if err != nil {
+		t.Fatalf("failed to verify token: %v", err)
+	}
+	if uid != "user1" {
+		t.Errorf("expected uid user1, got %q", uid)
+	}
+}
+
+func TestTokenSignerExpired(t *testing.T) {
+	signer := newTestTokenSigner(t, testTokenKey("k1"))
+	now := time.Now()
+
+	token, expireAt, err := signer.Sign("user1", now)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	if _, err := signer.Verify(token, expireAt.Add(-time.Second)); err != nil {
+		t.Errorf("expected token to be valid before expiry, got %v", err)
+	}
+	if _, err := signer.Verify(token, expireAt); !errors.Is(err, ErrTokenExpired) {
+		t.Errorf("expected expired error at expiry, got %v", err)
+	}
+}
+
+func TestTokenSignerUnknownKey(t *testing.T) {
+	signer := newTestTokenSigner(t, testTokenKey("k1"))
+	other := newTestTokenSigner(t, testTokenKey("k2"))
+	now := time.Now()
+
+	token, _, err := other.Sign("user1", now)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	if _, err := signer.Verify(token, now); !errors.Is(err, ErrTokenUnknownKey) {
+		t.Errorf("expected unknown key error, got %v", err)
+	}
+}
+
+func TestTokenSignerKeyRotation(t *testing.T) {
+	oldSigner := newTestTokenSigner(t, testTokenKey("k1"))
+	rotated := newTestTokenSigner(t, testTokenKey("k2"), testTokenKey("k1"))
+	now := time.Now()
+
+	// 새 키를 앞에 추가해도 기존 키로 서명한 토큰은 검증할 수 있습니다
+	oldToken, _, err := oldSigner.Sign("user1", now)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	if uid, err := rotated.Verify(oldToken, now); err != nil || uid != "user1" {
+		t.Errorf("expected old token to be valid, got uid=%q err=%v", uid, err)
+	}
+
+	newToken, _, err := rotated.Sign("user1", now)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	if !strings.HasPrefix(newToken, "k2.") {
+		t.Errorf("expected new token to be signed with first key, got %v", newToken)
+	}
+}
+
+func TestTokenSignerTampered(t *testing.T) {
+	signer := newTestTokenSigner(t, testTokenKey("k1"))
+	now := time.Now()
+
+	token, _, err := signer.Sign("user1", now)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	parts := strings.Split(token, ".")
+
+	// 서명은 그대로 두고 다른 유저 고유 ID로 내용만 바꿉니다
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"uid":"user2","exp":%d}`, now.Add(time.Hour).Unix())))
+	tests := []struct {
+		name  string
+		token string
+		want  error
+	}{
+		{"payload", parts[0] + "." + payload + "." + parts[2], ErrTokenSignature},
+		{"signature", parts[0] + "." + parts[1] + "." + base64.RawURLEncoding.EncodeToString([]byte("forged")), ErrTokenSignature},
+		{"key id", "k2." + parts[1] + "." + parts[2], ErrTokenUnknownKey},
+		{"malformed", parts[0] + "." + parts[1], ErrTokenMalformed},
+		{"bad encoding", parts[0] + "." + parts[1] + ".!!!", ErrTokenMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := signer.Verify(tt.token, now); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestNewTokenSignerInvalidKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []*TokenKey
+	}{
+		{"empty", nil},
+		{"nil key", []*TokenKey{nil}},
+		{"empty id", []*TokenKey{{Id: "", Secret: testTokenKey("k1").Secret}}},
+		{"dot in id", []*TokenKey{{Id: "k.1", Secret: testTokenKey("k1").Secret}}},
+		{"short secret", []*TokenKey{{Id: "k1", Secret: []byte("short")}}},
+		{"duplicate id", []*TokenKey{testTokenKey("k1"), testTokenKey("k1")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTokenSigner(tt.keys, time.Minute); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+
+	if _, err := NewTokenSigner(nil, time.Minute); !errors.Is(err, ErrTokenKeyIsEmpty) {
+		t.Errorf("expected empty key error, got %v", err)
+	}
+}
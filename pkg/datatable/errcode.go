@@ -0,0 +1,20 @@
+package datatable
+
+import "MScannot206/shared"
+
+// admin
+const DATATABLE_ADMIN_KEY_INVALID_ERROR = "DATATABLE_ADMIN_KEY_INVALID_ERROR"
+
+// reload
+const DATATABLE_RELOAD_ERROR = "DATATABLE_RELOAD_ERROR"
+const DATATABLE_VALIDATION_ERROR = "DATATABLE_VALIDATION_ERROR"
+
+func init() {
+
+	// admin
+	shared.RegisterError(DATATABLE_ADMIN_KEY_INVALID_ERROR, "관리자 키가 올바르지 않습니다")
+
+	// reload
+	shared.RegisterError(DATATABLE_RELOAD_ERROR, "데이터 테이블을 다시 불러오지 못했습니다")
+	shared.RegisterError(DATATABLE_VALIDATION_ERROR, "데이터 테이블 검증에 실패했습니다")
+}
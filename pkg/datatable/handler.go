@@ -0,0 +1,14 @@
+package datatable
+
+import (
+	"MScannot206/shared/table"
+	"errors"
+)
+
+var ErrTableReloadHandlerIsNil = errors.New("table reload handler is null")
+
+// 테이블 리로드 핸들러는 새로 불러온 테이블 레포지토리로 교체되어야 하는 서비스가 구현하는 핸들러입니다
+// 에러를 반환하는 경우 핸들러는 기존 테이블을 그대로 사용해야 합니다
+type TableReloadHandler interface {
+	OnTableReload(tableRepo *table.Repository) error
+}
@@ -0,0 +1,217 @@
+package datatable
+
+import (
+	"MScannot206/shared/table"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+func NewDataTableService(
+	tableRepo *table.Repository,
+	dataPath string,
+	adminKey string,
+	watchInterval time.Duration,
+) (*DataTableService, error) {
+	if tableRepo == nil {
+		return nil, table.ErrTableRepositoryIsNil
+	}
+
+	s := &DataTableService{
+		dataPath:      dataPath,
+		adminKey:      adminKey,
+		watchInterval: watchInterval,
+	}
+	s.tableRepo.Store(tableRepo)
+
+	return s, nil
+}
+
+// 데이터 테이블 서비스는 데이터 테이블을 다시 불러와 서비스들에 교체해주는 서비스입니다
+type DataTableService struct {
+	// 데이터 테이블(CSV) 경로
+	dataPath string
+
+	// 관리자 키 (비어 있으면 관리자 API를 사용할 수 없음)
+	adminKey string
+
+	// 파일 변경 감시 주기 (0 이하이면 감시하지 않음)
+	watchInterval time.Duration
+
+	// 현재 테이블 레포지토리
+	tableRepo atomic.Pointer[table.Repository]
+
+	// 테이블 리로드 핸들러 목록
+	reloadHandlers []TableReloadHandler
+
+	// 리로드 직렬화 뮤텍스
+	reloadMu sync.Mutex
+
+	// 마지막으로 불러온 CSV 파일 수정 시각
+	modTimes map[string]time.Time
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func (s *DataTableService) Start(ctx context.Context) error {
+	s.modTimes = s.readModTimes()
+
+	if s.watchInterval <= 0 {
+		return nil
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+
+	s.wg.Go(func() {
+		s.watch(watchCtx)
+	})
+
+	log.Info().Msgf("데이터 테이블 변경 감시를 시작합니다. [path:%v, interval:%v]", s.dataPath, s.watchInterval)
+	return nil
+}
+
+func (s *DataTableService) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return nil
+}
+
+func (s *DataTableService) SetHandlers(reloadHandlers ...TableReloadHandler) error {
+	var errs error
+
+	s.reloadHandlers = make([]TableReloadHandler, 0, len(reloadHandlers))
+	for _, h := range reloadHandlers {
+		if h == nil {
+			errs = errors.Join(errs, ErrTableReloadHandlerIsNil)
+			continue
+		}
+		s.reloadHandlers = append(s.reloadHandlers, h)
+	}
+
+	return errs
+}
+
+// 현재 사용 중인 테이블 레포지토리를 가져옵니다
+func (s *DataTableService) GetRepository() *table.Repository {
+	return s.tableRepo.Load()
+}
+
+// 관리자 키가 일치하는지 확인합니다
+func (s *DataTableService) IsValidAdminKey(adminKey string) bool {
+	if s.adminKey == "" || adminKey == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(s.adminKey), []byte(adminKey)) == 1
+}
+
+// 데이터 테이블을 새로 불러와 검증한 뒤 등록된 핸들러들에 교체합니다
+// 불러오기나 검증에 실패하면 핸들러를 호출하지 않고 기존 테이블 레포지토리를 그대로 사용합니다
+func (s *DataTableService) Reload(ctx context.Context) error {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	modTimes := s.readModTimes()
+
+	tableRepo := &table.Repository{}
+	if err := tableRepo.Load(s.dataPath); err != nil {
+		log.Err(err).Msg("데이터 테이블 리로드 실패, 기존 테이블을 유지합니다")
+		return err
+	}
+
+	if err := tableRepo.Validate(); err != nil {
+		log.Err(err).Msg("데이터 테이블 검증 실패, 기존 테이블을 유지합니다")
+		return err
+	}
+
+	var errs error
+	for _, h := range s.reloadHandlers {
+		if err := h.OnTableReload(tableRepo); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+	if errs != nil {
+		log.Err(errs).Msg("데이터 테이블 교체 실패, 실패한 핸들러는 기존 테이블을 유지합니다")
+		return errs
+	}
+
+	s.tableRepo.Store(tableRepo)
+	s.modTimes = modTimes
+
+	log.Info().Msgf("데이터 테이블을 다시 불러왔습니다. [path:%v]", s.dataPath)
+	return nil
+}
+
+func (s *DataTableService) watch(ctx context.Context) {
+	ticker := time.NewTicker(s.watchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !s.isModified() {
+				continue
+			}
+
+			log.Info().Msg("데이터 테이블 변경이 감지되었습니다")
+			if err := s.Reload(ctx); err != nil {
+				// 같은 변경으로 계속 실패하지 않도록 수정 시각은 갱신합니다
+				s.reloadMu.Lock()
+				s.modTimes = s.readModTimes()
+				s.reloadMu.Unlock()
+			}
+		}
+	}
+}
+
+func (s *DataTableService) isModified() bool {
+	modTimes := s.readModTimes()
+
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	if len(modTimes) != len(s.modTimes) {
+		return true
+	}
+	for name, modTime := range modTimes {
+		if prev, ok := s.modTimes[name]; !ok || !prev.Equal(modTime) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *DataTableService) readModTimes() map[string]time.Time {
+	entries, err := os.ReadDir(s.dataPath)
+	if err != nil {
+		log.Err(err).Msgf("데이터 테이블 경로를 읽을 수 없습니다. [path:%v]", s.dataPath)
+		return map[string]time.Time{}
+	}
+
+	ret := make(map[string]time.Time, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.EqualFold(filepath.Ext(entry.Name()), ".csv") {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		ret[entry.Name()] = info.ModTime()
+	}
+	return ret
+}
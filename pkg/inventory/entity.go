@@ -0,0 +1,58 @@
+package inventory
+
+// 인벤토리 소유자 정보
+type InventoryOwner struct {
+	// 유저 고유 ID
+	Uid string
+
+	// 인벤토리 슬롯 (0: 계정 공용, 1 이상: 캐릭터 슬롯)
+	Slot int
+}
+
+// 아이템 지급 정보
+type InventoryGrant struct {
+	// 유저 고유 ID
+	Uid string
+
+	// 지급할 인벤토리 슬롯
+	Slot int
+
+	// 지급할 아이템 테이블 인덱스
+	Index string
+
+	// 지급할 아이템 개수
+	Count int64
+}
+
+// 아이템 이동 정보
+type InventoryMove struct {
+	// 유저 고유 ID
+	Uid string
+
+	// 이동할 아이템 ID
+	ItemId string
+
+	// 출발 인벤토리 슬롯
+	FromSlot int
+
+	// 도착 인벤토리 슬롯
+	ToSlot int
+
+	// 이동할 아이템 개수
+	Count int64
+}
+
+// 아이템 버리기 정보
+type InventoryDiscard struct {
+	// 유저 고유 ID
+	Uid string
+
+	// 버릴 아이템 ID
+	ItemId string
+
+	// 아이템이 보관된 인벤토리 슬롯
+	Slot int
+
+	// 버릴 아이템 개수
+	Count int64
+}
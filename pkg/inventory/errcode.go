@@ -0,0 +1,40 @@
+package inventory
+
+import "MScannot206/shared"
+
+// inventory
+const INVENTORY_UNKNOWN_ERROR = "INVENTORY_UNKNOWN_ERROR"
+const INVENTORY_SLOT_INVALID_ERROR = "INVENTORY_SLOT_INVALID_ERROR"
+const INVENTORY_CHARACTER_NOT_FOUND_ERROR = "INVENTORY_CHARACTER_NOT_FOUND_ERROR"
+const INVENTORY_DB_WRITE_ERROR = "INVENTORY_DB_WRITE_ERROR"
+
+// item
+const INVENTORY_ITEM_NOT_FOUND_ERROR = "INVENTORY_ITEM_NOT_FOUND_ERROR"
+const INVENTORY_ITEM_NOT_ENOUGH_ERROR = "INVENTORY_ITEM_NOT_ENOUGH_ERROR"
+const INVENTORY_ITEM_COUNT_INVALID_ERROR = "INVENTORY_ITEM_COUNT_INVALID_ERROR"
+const INVENTORY_ITEM_INDEX_INVALID_ERROR = "INVENTORY_ITEM_INDEX_INVALID_ERROR"
+const INVENTORY_ITEM_NOT_STORABLE_ERROR = "INVENTORY_ITEM_NOT_STORABLE_ERROR"
+const INVENTORY_ITEM_BOUND_ERROR = "INVENTORY_ITEM_BOUND_ERROR"
+
+// move
+const INVENTORY_MOVE_SAME_SLOT_ERROR = "INVENTORY_MOVE_SAME_SLOT_ERROR"
+
+func init() {
+
+	// inventory
+	shared.RegisterError(INVENTORY_UNKNOWN_ERROR, "인벤토리 처리 중 알 수 없는 오류가 발생하였습니다")
+	shared.RegisterError(INVENTORY_SLOT_INVALID_ERROR, "잘못된 인벤토리 슬롯입니다")
+	shared.RegisterError(INVENTORY_CHARACTER_NOT_FOUND_ERROR, "해당 슬롯에 캐릭터가 존재하지 않습니다")
+	shared.RegisterError(INVENTORY_DB_WRITE_ERROR, "인벤토리 처리 중 데이터베이스 쓰기 오류가 발생하였습니다")
+
+	// item
+	shared.RegisterError(INVENTORY_ITEM_NOT_FOUND_ERROR, "아이템을 찾을 수 없습니다")
+	shared.RegisterError(INVENTORY_ITEM_NOT_ENOUGH_ERROR, "아이템 개수가 부족합니다")
+	shared.RegisterError(INVENTORY_ITEM_COUNT_INVALID_ERROR, "잘못된 아이템 개수입니다")
+	shared.RegisterError(INVENTORY_ITEM_INDEX_INVALID_ERROR, "존재하지 않는 아이템입니다")
+	shared.RegisterError(INVENTORY_ITEM_NOT_STORABLE_ERROR, "인벤토리에 보관할 수 없는 아이템입니다")
+	shared.RegisterError(INVENTORY_ITEM_BOUND_ERROR, "귀속된 아이템은 이동할 수 없습니다")
+
+	// move
+	shared.RegisterError(INVENTORY_MOVE_SAME_SLOT_ERROR, "같은 인벤토리로 이동할 수 없습니다")
+}
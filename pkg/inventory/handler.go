@@ -0,0 +1,14 @@
+package inventory
+
+import (
+	"MScannot206/shared/entity"
+	"context"
+	"errors"
+)
+
+var ErrUserRepositoryHandlerIsNil = errors.New("user repository handler is null")
+
+// 유저 레포지토리 핸들러는 인벤토리 서비스에서 캐릭터 존재 여부를 확인하기 위해 사용하는 핸들러입니다
+type UserRepositoryHandler interface {
+	FindCharacters(ctx context.Context, uids []string) (map[string][]*entity.Character, error)
+}
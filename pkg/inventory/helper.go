@@ -0,0 +1,13 @@
+package inventory
+
+import "MScannot206/shared/def"
+
+// 인벤토리 슬롯 판별 (0: 계정 공용, 1 이상: 캐릭터 슬롯)
+func IsInvalidInventorySlot(slot int) bool {
+	return slot < def.AccountInventorySlot || slot > def.MaxCharacterSlot
+}
+
+// 캐릭터 인벤토리 슬롯인지 판별
+func IsCharacterInventorySlot(slot int) bool {
+	return slot != def.AccountInventorySlot
+}
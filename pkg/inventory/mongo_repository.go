@@ -0,0 +1,276 @@
+package inventory
+
+import (
+	"MScannot206/shared"
+	"MScannot206/shared/entity"
+	"MScannot206/shared/types"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var ErrInventoryMongoRepositoryIsNil = errors.New("inventory mongo repository is null")
+
+func NewInventoryMongoRepository(
+	ctx context.Context,
+	client *mongo.Client,
+	dbName string,
+) (*InventoryMongoRepository, error) {
+
+	if client == nil {
+		return nil, errors.New("mongo client is null")
+	}
+
+	if dbName == "" {
+		return nil, errors.New("database name is empty")
+	}
+
+	repo := &InventoryMongoRepository{
+		client: client,
+
+		inventory: client.Database(dbName).Collection(shared.Inventory),
+	}
+
+	if err := repo.ensureIndexes(ctx); err != nil {
+		return nil, err
+	}
+
+	return repo, nil
+}
+
+type InventoryMongoRepository struct {
+	client *mongo.Client
+
+	inventory *mongo.Collection
+}
+
+func (r *InventoryMongoRepository) ensureIndexes(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// 같은 인벤토리 내 동일 인덱스/귀속 여부의 아이템은 하나로 합쳐짐
+	stackIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "uid", Value: 1},
+			{Key: "slot", Value: 1},
+			{Key: "index", Value: 1},
+			{Key: "bound", Value: 1},
+		},
+		Options: options.Index().
+			SetName("inventory_stack_idx").
+			SetUnique(true),
+	}
+
+	_, err := r.inventory.Indexes().CreateOne(ctx, stackIndex)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *InventoryMongoRepository) FindItems(ctx context.Context, owners []*InventoryOwner) (map[string][]*entity.InventoryItem, error) {
+	itemMap := make(map[string][]*entity.InventoryItem, len(owners))
+	if len(owners) == 0 {
+		return itemMap, nil
+	}
+
+	conditions := make(bson.A, 0, len(owners))
+	for _, owner := range owners {
+		itemMap[owner.Uid] = []*entity.InventoryItem{}
+		conditions = append(conditions, bson.D{
+			{Key: "uid", Value: owner.Uid},
+			{Key: "slot", Value: owner.Slot},
+		})
+	}
+
+	filter := bson.D{
+		{Key: "$or", Value: conditions},
+	}
+
+	cursor, err := r.inventory.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var items []*entity.InventoryItem
+	if err := cursor.All(ctx, &items); err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		itemMap[item.Uid] = append(itemMap[item.Uid], item)
+	}
+
+	return itemMap, nil
+}
+
+func (r *InventoryMongoRepository) FindItemsByIds(ctx context.Context, itemIds []string) (map[string]*entity.InventoryItem, error) {
+	itemMap := make(map[string]*entity.InventoryItem, len(itemIds))
+	if len(itemIds) == 0 {
+		return itemMap, nil
+	}
+
+	filter := bson.D{
+		{Key: "_id", Value: bson.D{{Key: "$in", Value: itemIds}}},
+	}
+
+	cursor, err := r.inventory.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var items []*entity.InventoryItem
+	if err := cursor.All(ctx, &items); err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		itemMap[item.Id] = item
+	}
+
+	return itemMap, nil
+}
+
+// 아이템을 지급합니다. 같은 인벤토리에 동일 인덱스/귀속 여부의 아이템이 있다면 개수를 합칩니다
+func (r *InventoryMongoRepository) AddItems(ctx context.Context, items []*entity.InventoryItem) ([]string, error) {
+	errorCodes := make([]string, len(items))
+	if len(items) == 0 {
+		return errorCodes, nil
+	}
+
+	writeModels := make([]mongo.WriteModel, len(items))
+	for i, item := range items {
+		writeModels[i] = newStackUpsertModel(item.Uid, item.Slot, item.Index, item.Bound, item.InventoryType, item.Count)
+	}
+
+	_, err := r.inventory.BulkWrite(ctx, writeModels, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		if bulkErr, ok := err.(mongo.BulkWriteException); ok {
+			log.Warn().Msg("일부 아이템 지급에 실패했습니다")
+			for _, writeErr := range bulkErr.WriteErrors {
+				log.Warn().Msgf("아이템 지급 실패: %v - %v", items[writeErr.Index].Index, writeErr.Message)
+				errorCodes[writeErr.Index] = INVENTORY_DB_WRITE_ERROR
+			}
+		} else {
+			return nil, err
+		}
+	}
+
+	return errorCodes, nil
+}
+
+// 아이템을 다른 인벤토리로 이동합니다. 출발 인벤토리에서 차감 후 도착 인벤토리에 합칩니다
+func (r *InventoryMongoRepository) MoveItem(ctx context.Context, item *entity.InventoryItem, toSlot int, count int64) (string, error) {
+	if errCode, err := r.RemoveItem(ctx, item, count); err != nil || errCode != "" {
+		return errCode, err
+	}
+
+	model := newStackUpsertModel(item.Uid, toSlot, item.Index, item.Bound, item.InventoryType, count)
+	if _, err := r.inventory.BulkWrite(ctx, []mongo.WriteModel{model}); err != nil {
+		// 도착 인벤토리 지급 실패 시 출발 인벤토리로 복구
+		restore := newStackUpsertModel(item.Uid, item.Slot, item.Index, item.Bound, item.InventoryType, count)
+		if _, restoreErr := r.inventory.BulkWrite(ctx, []mongo.WriteModel{restore}); restoreErr != nil {
+			// 출발 인벤토리에서 차감만 된 상태이므로 수동 복구에 필요한 정보를 남깁니다
+			log.Error().Err(restoreErr).AnErr("move_err", err).
+				Str("uid", item.Uid).
+				Str("item", item.Index).
+				Int("slot", item.Slot).
+				Int("to_slot", toSlot).
+				Bool("bound", item.Bound).
+				Int64("count", count).
+				Msg("아이템 이동 복구 실패")
+		}
+		return INVENTORY_DB_WRITE_ERROR, err
+	}
+
+	return "", nil
+}
+
+// 아이템 개수를 차감합니다. 개수가 0이 된 아이템은 삭제됩니다
+// 조회한 묶음의 귀속 여부와 다르면 차감하지 않으므로 차감한 아이템은 item.Bound를 그대로 사용할 수 있습니다
+func (r *InventoryMongoRepository) RemoveItem(ctx context.Context, item *entity.InventoryItem, count int64) (string, error) {
+	filter := bson.D{
+		{Key: "_id", Value: item.Id},
+		{Key: "uid", Value: item.Uid},
+		{Key: "slot", Value: item.Slot},
+		{Key: "bound", Value: item.Bound},
+		{Key: "count", Value: bson.D{{Key: "$gte", Value: count}}},
+	}
+
+	update := bson.D{
+		{Key: "$inc", Value: bson.D{
+			{Key: "count", Value: -count},
+		}},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var updated entity.InventoryItem
+	if err := r.inventory.FindOneAndUpdate(ctx, filter, update, opts).Decode(&updated); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			// 조회 이후 다른 요청에 의해 개수가 변경됨
+			return INVENTORY_ITEM_NOT_ENOUGH_ERROR, nil
+		}
+		return INVENTORY_DB_WRITE_ERROR, err
+	}
+
+	if updated.Count <= 0 {
+		deleteFilter := bson.D{
+			{Key: "_id", Value: updated.Id},
+			{Key: "count", Value: bson.D{{Key: "$lte", Value: 0}}},
+		}
+		if _, err := r.inventory.DeleteOne(ctx, deleteFilter); err != nil {
+			log.Warn().Err(err).Msgf("빈 아이템 삭제 실패: %v", updated.Id)
+		}
+	}
+
+	return "", nil
+}
+
+func (r *InventoryMongoRepository) DeleteInventories(ctx context.Context, owners []*InventoryOwner) error {
+	if len(owners) == 0 {
+		return nil
+	}
+
+	writeModels := make([]mongo.WriteModel, len(owners))
+	for i, owner := range owners {
+		filter := bson.D{
+			{Key: "uid", Value: owner.Uid},
+			{Key: "slot", Value: owner.Slot},
+		}
+		writeModels[i] = mongo.NewDeleteManyModel().SetFilter(filter)
+	}
+
+	_, err := r.inventory.BulkWrite(ctx, writeModels, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+func newStackUpsertModel(uid string, slot int, index string, bound bool, inventoryType types.InventoryType, count int64) mongo.WriteModel {
+	filter := bson.D{
+		{Key: "uid", Value: uid},
+		{Key: "slot", Value: slot},
+		{Key: "index", Value: index},
+		{Key: "bound", Value: bound},
+	}
+
+	update := bson.D{
+		{Key: "$inc", Value: bson.D{
+			{Key: "count", Value: count},
+		}},
+		{Key: "$setOnInsert", Value: bson.D{
+			{Key: "_id", Value: primitive.NewObjectID().Hex()},
+			{Key: "inventory_type", Value: inventoryType},
+		}},
+	}
+
+	return mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(update).SetUpsert(true)
+}
@@ -0,0 +1,540 @@
+package inventory
+
+import (
+	"MScannot206/shared/def"
+	"MScannot206/shared/entity"
+	"MScannot206/shared/table"
+	"MScannot206/shared/types"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync/atomic"
+
+	"github.com/rs/zerolog/log"
+)
+
+func NewInventoryService() (*InventoryService, error) {
+	return &InventoryService{}, nil
+}
+
+// 인벤토리 서비스는 유저/캐릭터 인벤토리의 아이템을 관리하는 서비스입니다
+type InventoryService struct {
+	// 테이블 레포지토리 (테이블 리로드 시 교체됩니다)
+	tableRepo atomic.Pointer[table.Repository]
+
+	// 인벤토리 DB 레포지토리
+	inventoryRepo *InventoryMongoRepository
+
+	// 유저 레포지토리 핸들러
+	userRepoHandler UserRepositoryHandler
+}
+
+func (s *InventoryService) Start(ctx context.Context) error {
+	return nil
+}
+
+func (s *InventoryService) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (s *InventoryService) SetRepositories(
+	tableRepo *table.Repository,
+	inventoryRepo *InventoryMongoRepository,
+	userRepo UserRepositoryHandler,
+) error {
+	var errs error
+
+	if tableRepo == nil {
+		errs = errors.Join(errs, table.ErrTableRepositoryIsNil)
+	} else if err := s.OnTableReload(tableRepo); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	s.inventoryRepo = inventoryRepo
+	if inventoryRepo == nil {
+		errs = errors.Join(errs, ErrInventoryMongoRepositoryIsNil)
+	}
+
+	s.userRepoHandler = userRepo
+	if userRepo == nil {
+		errs = errors.Join(errs, ErrUserRepositoryHandlerIsNil)
+	}
+
+	return errs
+}
+
+// 새로 불러온 테이블 레포지토리로 교체합니다
+func (s *InventoryService) OnTableReload(tableRepo *table.Repository) error {
+	s.tableRepo.Store(tableRepo)
+	return nil
+}
+
+// 캐릭터 슬롯 인벤토리의 경우 캐릭터가 존재하는지 확인합니다
+func (s *InventoryService) validateOwners(ctx context.Context, owners []*InventoryOwner) (map[*InventoryOwner]string, error) {
+	failures := make(map[*InventoryOwner]string)
+
+	uids := make([]string, 0, len(owners))
+	for _, owner := range owners {
+		if IsInvalidInventorySlot(owner.Slot) {
+			failures[owner] = INVENTORY_SLOT_INVALID_ERROR
+			continue
+		}
+
+		if IsCharacterInventorySlot(owner.Slot) {
+			uids = append(uids, owner.Uid)
+		}
+	}
+
+	if len(uids) == 0 {
+		return failures, nil
+	}
+
+	userCharacters, err := s.userRepoHandler.FindCharacters(ctx, uids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, owner := range owners {
+		if _, failed := failures[owner]; failed || !IsCharacterInventorySlot(owner.Slot) {
+			continue
+		}
+
+		found := false
+		for _, ch := range userCharacters[owner.Uid] {
+			if ch.Slot == owner.Slot {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			failures[owner] = INVENTORY_CHARACTER_NOT_FOUND_ERROR
+		}
+	}
+
+	return failures, nil
+}
+
+// 인벤토리 아이템 목록을 조회합니다
+func (s *InventoryService) FindInventories(ctx context.Context, owners []*InventoryOwner) (map[string][]*entity.InventoryItem, map[string]string, error) {
+	failureUids := make(map[string]string)
+	if len(owners) == 0 {
+		return map[string][]*entity.InventoryItem{}, failureUids, nil
+	}
+
+	ownerFailures, err := s.validateOwners(ctx, owners)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validOwners := make([]*InventoryOwner, 0, len(owners))
+	for _, owner := range owners {
+		if errCode, failed := ownerFailures[owner]; failed {
+			failureUids[owner.Uid] = errCode
+			continue
+		}
+		validOwners = append(validOwners, owner)
+	}
+
+	items, err := s.inventoryRepo.FindItems(ctx, validOwners)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return items, failureUids, nil
+}
+
+// 아이템을 지급합니다. 반환되는 오류 코드는 지급 정보와 같은 순서이며 성공 시 빈 문자열입니다
+func (s *InventoryService) AddItems(ctx context.Context, grants []*InventoryGrant) ([]string, error) {
+	errorCodes := make([]string, len(grants))
+	if len(grants) == 0 {
+		return errorCodes, nil
+	}
+
+	owners := make([]*InventoryOwner, len(grants))
+	for i, grant := range grants {
+		owners[i] = &InventoryOwner{Uid: grant.Uid, Slot: grant.Slot}
+	}
+
+	ownerFailures, err := s.validateOwners(ctx, owners)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*entity.InventoryItem, 0, len(grants))
+	itemPositions := make([]int, 0, len(grants))
+	for i, grant := range grants {
+		if errCode, failed := ownerFailures[owners[i]]; failed {
+			errorCodes[i] = errCode
+			continue
+		}
+
+		item, errCode := s.newInventoryItem(grant)
+		if errCode != "" {
+			errorCodes[i] = errCode
+			continue
+		}
+
+		items = append(items, item)
+		itemPositions = append(itemPositions, i)
+	}
+
+	writeErrorCodes, err := s.inventoryRepo.AddItems(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, errCode := range writeErrorCodes {
+		if errCode != "" {
+			errorCodes[itemPositions[i]] = errCode
+		}
+	}
+
+	return errorCodes, nil
+}
+
+// 아이템 테이블을 통해 지급할 인벤토리 아이템을 생성합니다
+func (s *InventoryService) newInventoryItem(grant *InventoryGrant) (*entity.InventoryItem, string) {
+	if grant.Count <= 0 {
+		return nil, INVENTORY_ITEM_COUNT_INVALID_ERROR
+	}
+
+	tableRepo := s.tableRepo.Load()
+	items, ok := table.Get[*table.ItemTable](tableRepo)
+	if !ok {
+		log.Error().Msg("아이템 테이블이 없습니다")
+		return nil, INVENTORY_UNKNOWN_ERROR
+	}
+
+	record, ok := items.Get(grant.Index)
+	if !ok {
+		return nil, INVENTORY_ITEM_INDEX_INVALID_ERROR
+	}
+
+	inventoryType := record.InventoryType
+	if inventoryType == types.InventoryType_None {
+		return nil, INVENTORY_ITEM_NOT_STORABLE_ERROR
+	}
+
+	equipItems, ok := table.Get[*table.CharacterEquipItemTable](tableRepo)
+	if !ok {
+		log.Error().Msg("캐릭터 장착 아이템 테이블이 없습니다")
+		return nil, INVENTORY_UNKNOWN_ERROR
+	}
+
+	var bound bool
+	if equipRecord, ok := equipItems.Get(grant.Index); ok {
+		bound = equipRecord.Bound
+	}
+
+	return &entity.InventoryItem{
+		Item:          *entity.NewItem("", grant.Index, grant.Count, bound),
+		Uid:           grant.Uid,
+		Slot:          grant.Slot,
+		InventoryType: inventoryType,
+	}, ""
+}
+
+// 아이템을 다른 인벤토리로 이동합니다. 귀속된 아이템은 이동할 수 없습니다
+// 출발, 도착 인벤토리의 소유 캐릭터를 모두 확인하며, 이동한 아이템은 저장된 묶음의 귀속 여부를 그대로 유지합니다
+func (s *InventoryService) MoveItems(ctx context.Context, moves []*InventoryMove) (map[string]string, error) {
+	failureUids := make(map[string]string, len(moves))
+	if len(moves) == 0 {
+		return failureUids, nil
+	}
+
+	owners := make([]*InventoryOwner, 0, len(moves))
+	validMoves := make([]*InventoryMove, 0, len(moves))
+	for _, move := range moves {
+		if move.Count <= 0 {
+			failureUids[move.Uid] = INVENTORY_ITEM_COUNT_INVALID_ERROR
+			continue
+		}
+
+		if move.FromSlot == move.ToSlot {
+			failureUids[move.Uid] = INVENTORY_MOVE_SAME_SLOT_ERROR
+			continue
+		}
+
+		// 출발, 도착 인벤토리 모두 캐릭터 슬롯이면 캐릭터가 존재해야 합니다
+		owners = append(owners,
+			&InventoryOwner{Uid: move.Uid, Slot: move.FromSlot},
+			&InventoryOwner{Uid: move.Uid, Slot: move.ToSlot},
+		)
+		validMoves = append(validMoves, move)
+	}
+
+	ownerFailures, err := s.validateOwners(ctx, owners)
+	if err != nil {
+		return nil, err
+	}
+
+	for owner, errCode := range ownerFailures {
+		failureUids[owner.Uid] = errCode
+	}
+
+	items, err := s.inventoryRepo.FindItemsByIds(ctx, func() []string {
+		itemIds := make([]string, 0, len(validMoves))
+		for _, move := range validMoves {
+			itemIds = append(itemIds, move.ItemId)
+		}
+		return itemIds
+	}())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, move := range validMoves {
+		if _, failed := failureUids[move.Uid]; failed {
+			continue
+		}
+
+		// 귀속 여부는 테이블이 아닌 저장된 아이템 묶음을 기준으로 합니다
+		item, errCode := validateItem(items[move.ItemId], move.Uid, move.FromSlot, move.Count)
+		if errCode == "" && item.Bound {
+			errCode = INVENTORY_ITEM_BOUND_ERROR
+		}
+
+		if errCode != "" {
+			failureUids[move.Uid] = errCode
+			continue
+		}
+
+		errCode, err := s.inventoryRepo.MoveItem(ctx, item, move.ToSlot, move.Count)
+		if err != nil {
+			log.Err(err).Msgf("아이템 이동 실패: %v - %v", move.Uid, move.ItemId)
+		}
+		if errCode != "" {
+			failureUids[move.Uid] = errCode
+		}
+	}
+
+	return failureUids, nil
+}
+
+// 아이템을 버립니다
+func (s *InventoryService) DiscardItems(ctx context.Context, discards []*InventoryDiscard) (map[string]string, error) {
+	failureUids := make(map[string]string, len(discards))
+	if len(discards) == 0 {
+		return failureUids, nil
+	}
+
+	items, err := s.inventoryRepo.FindItemsByIds(ctx, func() []string {
+		itemIds := make([]string, 0, len(discards))
+		for _, discard := range discards {
+			itemIds = append(itemIds, discard.ItemId)
+		}
+		return itemIds
+	}())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, discard := range discards {
+		if discard.Count <= 0 {
+			failureUids[discard.Uid] = INVENTORY_ITEM_COUNT_INVALID_ERROR
+			continue
+		}
+
+		item, errCode := validateItem(items[discard.ItemId], discard.Uid, discard.Slot, discard.Count)
+		if errCode != "" {
+			failureUids[discard.Uid] = errCode
+			continue
+		}
+
+		errCode, err := s.inventoryRepo.RemoveItem(ctx, item, discard.Count)
+		if err != nil {
+			log.Err(err).Msgf("아이템 버리기 실패: %v - %v", discard.Uid, discard.ItemId)
+		}
+		if errCode != "" {
+			failureUids[discard.Uid] = errCode
+		}
+	}
+
+	return failureUids, nil
+}
+
+// 계정 공용 인벤토리에서 아이템을 소모하고 소모한 묶음을 반환합니다. 성공 시 에러 코드는 빈 문자열입니다
+// 귀속 여부로 나뉜 묶음은 귀속된 묶음부터 차례로 소모하며, 도중에 실패하면 이미 소모한 아이템을 되돌립니다
+// 반환된 묶음은 RestoreAccountItems로 귀속 여부 그대로 되돌릴 수 있습니다
+func (s *InventoryService) ConsumeAccountItem(ctx context.Context, uid string, index string, count int64) ([]*entity.InventoryItem, string, error) {
+	if count <= 0 {
+		return nil, INVENTORY_ITEM_COUNT_INVALID_ERROR, nil
+	}
+
+	items, err := s.inventoryRepo.FindItems(ctx, []*InventoryOwner{{Uid: uid, Slot: def.AccountInventorySlot}})
+	if err != nil {
+		return nil, "", err
+	}
+
+	var total int64
+	stacks := make([]*entity.InventoryItem, 0, 2)
+	for _, item := range items[uid] {
+		if item.Index == index && item.Count > 0 {
+			stacks = append(stacks, item)
+			total += item.Count
+		}
+	}
+
+	if total < count {
+		return nil, INVENTORY_ITEM_NOT_ENOUGH_ERROR, nil
+	}
+
+	slices.SortFunc(stacks, func(a, b *entity.InventoryItem) int {
+		if a.Bound == b.Bound {
+			return 0
+		}
+		if a.Bound {
+			return -1
+		}
+		return 1
+	})
+
+	consumed := make([]*entity.InventoryItem, 0, len(stacks))
+	remain := count
+	for _, item := range stacks {
+		removeCount := min(item.Count, remain)
+
+		errCode, err := s.inventoryRepo.RemoveItem(ctx, item, removeCount)
+		if err != nil || errCode != "" {
+			if restoreErr := s.RestoreAccountItems(ctx, consumed); restoreErr != nil {
+				log.Err(restoreErr).Msgf("소모한 아이템 되돌리기 실패: %v - %v", uid, index)
+			}
+			return nil, errCode, err
+		}
+
+		consumed = append(consumed, &entity.InventoryItem{
+			Item:          *entity.NewItem("", item.Index, removeCount, item.Bound),
+			Uid:           item.Uid,
+			Slot:          item.Slot,
+			InventoryType: item.InventoryType,
+		})
+
+		remain -= removeCount
+		if remain == 0 {
+			break
+		}
+	}
+
+	return consumed, "", nil
+}
+
+// ConsumeAccountItem으로 소모한 묶음을 귀속 여부 그대로 되돌립니다
+// 테이블로 귀속 여부를 다시 정하는 AddItems와 달리 소모한 묶음을 그대로 저장합니다
+func (s *InventoryService) RestoreAccountItems(ctx context.Context, consumed []*entity.InventoryItem) error {
+	if len(consumed) == 0 {
+		return nil
+	}
+
+	errorCodes, err := s.inventoryRepo.AddItems(ctx, consumed)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for i, errCode := range errorCodes {
+		if errCode != "" {
+			errs = errors.Join(errs, fmt.Errorf("%v: %v x%v (bound: %v)", errCode, consumed[i].Index, consumed[i].Count, consumed[i].Bound))
+		}
+	}
+	return errs
+}
+
+// 유저별로 캐릭터 인벤토리와 계정 공용 인벤토리에 있는 아이템 인덱스를 조회합니다 (귀속 여부는 구분하지 않습니다)
+// uidSlots는 유저 고유 ID별 캐릭터 슬롯 번호입니다
+func (s *InventoryService) FindOwnedItemIndexes(ctx context.Context, uidSlots map[string]int) (map[string]map[string]bool, error) {
+	owned := make(map[string]map[string]bool, len(uidSlots))
+	if len(uidSlots) == 0 {
+		return owned, nil
+	}
+
+	owners := make([]*InventoryOwner, 0, len(uidSlots)*2)
+	for uid, slot := range uidSlots {
+		owners = append(owners, &InventoryOwner{Uid: uid, Slot: def.AccountInventorySlot})
+		if IsCharacterInventorySlot(slot) {
+			owners = append(owners, &InventoryOwner{Uid: uid, Slot: slot})
+		}
+	}
+
+	items, err := s.inventoryRepo.FindItems(ctx, owners)
+	if err != nil {
+		return nil, err
+	}
+
+	for uid, userItems := range items {
+		indexes := make(map[string]bool, len(userItems))
+		for _, item := range userItems {
+			if item.Count > 0 {
+				indexes[item.Index] = true
+			}
+		}
+		owned[uid] = indexes
+	}
+
+	return owned, nil
+}
+
+// 캐릭터 생성 시 장착한 장비 중 인벤토리에 보관하는 아이템을 캐릭터 인벤토리에 지급합니다
+// 헤어, 얼굴 등 인벤토리에 보관하지 않는 아이템은 지급하지 않습니다
+func (s *InventoryService) AddCharacterEquipItems(ctx context.Context, characters map[string]*entity.Character) error {
+	items, err := table.Lookup[*table.ItemTable](s.tableRepo.Load())
+	if err != nil {
+		return err
+	}
+
+	grants := make([]*InventoryGrant, 0, len(characters))
+	for uid, character := range characters {
+		for _, equip := range character.Equips {
+			record, ok := items.Get(equip.Index)
+			if !ok || record.InventoryType == types.InventoryType_None {
+				continue
+			}
+
+			grants = append(grants, &InventoryGrant{
+				Uid:   uid,
+				Slot:  character.Slot,
+				Index: equip.Index,
+				Count: 1,
+			})
+		}
+	}
+
+	errorCodes, err := s.AddItems(ctx, grants)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for i, errCode := range errorCodes {
+		if errCode != "" {
+			errs = errors.Join(errs, fmt.Errorf("%v: %v - %v", errCode, grants[i].Uid, grants[i].Index))
+		}
+	}
+	return errs
+}
+
+// 삭제된 캐릭터의 인벤토리를 정리합니다
+func (s *InventoryService) DeleteCharacterInventories(ctx context.Context, uidSlots map[string]int) error {
+	owners := make([]*InventoryOwner, 0, len(uidSlots))
+	for uid, slot := range uidSlots {
+		if !IsCharacterInventorySlot(slot) {
+			continue
+		}
+		owners = append(owners, &InventoryOwner{Uid: uid, Slot: slot})
+	}
+	return s.inventoryRepo.DeleteInventories(ctx, owners)
+}
+
+// 요청한 유저/슬롯의 아이템인지, 개수가 충분한지 확인합니다
+func validateItem(item *entity.InventoryItem, uid string, slot int, count int64) (*entity.InventoryItem, string) {
+	if item == nil || item.Uid != uid || item.Slot != slot {
+		return nil, INVENTORY_ITEM_NOT_FOUND_ERROR
+	}
+
+	if item.Count < count {
+		return nil, INVENTORY_ITEM_NOT_ENOUGH_ERROR
+	}
+
+	return item, ""
+}
@@ -0,0 +1,52 @@
+package clicker
+
+// 클리커 몬스터 정보
+type ClickerMonster struct {
+	// 몬스터 테이블 인덱스
+	Index string
+
+	// 몬스터 모델 ID
+	ModelID string
+
+	// 남은 체력
+	Hp int64
+
+	// 최대 체력
+	MaxHp int64
+}
+
+// 클리커 보상 정보
+type ClickerReward struct {
+	// 지급된 아이템 테이블 인덱스
+	Index string
+
+	// 지급된 아이템 개수
+	Count int64
+}
+
+// 클릭 정보
+type ClickerClick struct {
+	// 유저 고유 ID
+	Uid string
+
+	// 마지막 요청 이후 클릭 횟수
+	Clicks int64
+}
+
+// 클릭 결과
+type ClickerClickResult struct {
+	// 유저 고유 ID
+	Uid string
+
+	// 클릭을 적용한 뒤의 몬스터 (처치 시 새로 생성된 몬스터)
+	Monster *ClickerMonster
+
+	// 처치한 몬스터 (처치하지 못한 경우 nil)
+	Killed *ClickerMonster
+
+	// 처치 보상 (보상이 없는 몬스터인 경우 nil)
+	Reward *ClickerReward
+
+	// 에러 코드
+	ErrorCode string
+}
@@ -0,0 +1,30 @@
+package clicker
+
+import "MScannot206/shared"
+
+// clicker
+const CLICKER_UNKNOWN_ERROR = "CLICKER_UNKNOWN_ERROR"
+const CLICKER_MONSTER_NOT_FOUND_ERROR = "CLICKER_MONSTER_NOT_FOUND_ERROR"
+const CLICKER_MONSTER_SPAWN_ERROR = "CLICKER_MONSTER_SPAWN_ERROR"
+
+// click
+const CLICKER_CLICK_COUNT_INVALID_ERROR = "CLICKER_CLICK_COUNT_INVALID_ERROR"
+const CLICKER_CLICK_RATE_EXCEEDED_ERROR = "CLICKER_CLICK_RATE_EXCEEDED_ERROR"
+
+// reward
+const CLICKER_REWARD_ERROR = "CLICKER_REWARD_ERROR"
+
+func init() {
+
+	// clicker
+	shared.RegisterError(CLICKER_UNKNOWN_ERROR, "클리커 미니게임 처리 중 알 수 없는 오류가 발생하였습니다")
+	shared.RegisterError(CLICKER_MONSTER_NOT_FOUND_ERROR, "진행 중인 클리커 몬스터가 없습니다")
+	shared.RegisterError(CLICKER_MONSTER_SPAWN_ERROR, "클리커 몬스터를 생성할 수 없습니다")
+
+	// click
+	shared.RegisterError(CLICKER_CLICK_COUNT_INVALID_ERROR, "잘못된 클릭 횟수입니다")
+	shared.RegisterError(CLICKER_CLICK_RATE_EXCEEDED_ERROR, "허용된 클릭 속도를 초과하였습니다")
+
+	// reward
+	shared.RegisterError(CLICKER_REWARD_ERROR, "클리커 보상 지급에 실패하였습니다")
+}
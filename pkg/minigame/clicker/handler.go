@@ -0,0 +1,22 @@
+package clicker
+
+import (
+	"MScannot206/pkg/inventory"
+	"context"
+	"errors"
+	"math/rand/v2"
+)
+
+var ErrRandomServiceHandlerIsNil = errors.New("random service handler is null")
+
+// 랜덤 서비스 핸들러는 클리커 서비스에서 몬스터 생성에 사용할 랜덤을 얻기 위해 사용하는 핸들러입니다
+type RandomServiceHandler interface {
+	NewClickerRand(ctx context.Context, uid string) (*rand.Rand, error)
+}
+
+var ErrInventoryServiceHandlerIsNil = errors.New("inventory service handler is null")
+
+// 인벤토리 서비스 핸들러는 클리커 서비스에서 몬스터 처치 보상을 지급하기 위해 사용하는 핸들러입니다
+type InventoryServiceHandler interface {
+	AddItems(ctx context.Context, grants []*inventory.InventoryGrant) ([]string, error)
+}
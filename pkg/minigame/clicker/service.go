@@ -0,0 +1,463 @@
+package clicker
+
+import (
+	"MScannot206/pkg/inventory"
+	"MScannot206/shared/def"
+	"MScannot206/shared/table"
+	"MScannot206/shared/util"
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// 초당 허용 클릭 수 기본값
+const DefaultMaxClicksPerSecond = 15
+
+// 클릭 허용량을 누적할 수 있는 최대 시간 (오래 쉬었다가 한 번에 보낸 클릭을 제한합니다)
+const clickBurstDuration = 10 * time.Second
+
+// 클릭이 없는 유저 상태를 정리하기까지의 시간
+const clickerStateTTL = 30 * time.Minute
+
+// 유저 상태 정리 주기
+const clickerJanitorInterval = time.Minute
+
+func NewClickerService(maxClicksPerSecond int) (*ClickerService, error) {
+	if maxClicksPerSecond <= 0 {
+		maxClicksPerSecond = DefaultMaxClicksPerSecond
+	}
+
+	return &ClickerService{
+		maxClicksPerSecond: float64(maxClicksPerSecond),
+		states:             make(map[string]*clickerState),
+	}, nil
+}
+
+// 유저별 클리커 진행 상태
+type clickerState struct {
+	// 현재 몬스터
+	monster ClickerMonster
+
+	// 몬스터를 처치하여 다음 몬스터 생성을 기다리는 중인지 여부
+	respawning bool
+
+	// 처치 보상을 지급하는 중인지 여부 (지급에 성공해야 처치가 확정됩니다)
+	granting bool
+
+	// 남은 클릭 허용량
+	allowance float64
+
+	// 마지막으로 허용량을 계산한 시각
+	checkedAt time.Time
+}
+
+// 클리커 서비스는 클리커 미니게임의 몬스터 생성, 체력, 처치 보상을 서버에서 관리하는 서비스입니다
+// 유저별 진행 상태는 이 서버의 메모리에만 보관하므로 클리커 API는 단일 서버에서만 제공해야 합니다
+// 여러 서버가 처리하면 서버마다 몬스터와 클릭 허용량이 따로 관리되며, 재시작하면 진행 상태가 초기화됩니다
+type ClickerService struct {
+	// 테이블 레포지토리 (테이블 리로드 시 교체됩니다)
+	tableRepo atomic.Pointer[table.Repository]
+
+	// 초당 허용 클릭 수
+	maxClicksPerSecond float64
+
+	// 유저별 진행 상태
+	states map[string]*clickerState
+	mu     sync.Mutex
+
+	randomServiceHandler    RandomServiceHandler
+	inventoryServiceHandler InventoryServiceHandler
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func (s *ClickerService) Start(ctx context.Context) error {
+	janitorCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+
+	s.wg.Go(func() {
+		s.janitor(janitorCtx)
+	})
+
+	return nil
+}
+
+func (s *ClickerService) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return nil
+}
+
+func (s *ClickerService) SetRepositories(tableRepo *table.Repository) error {
+	if tableRepo == nil {
+		return table.ErrTableRepositoryIsNil
+	}
+
+	return s.OnTableReload(tableRepo)
+}
+
+func (s *ClickerService) SetHandlers(
+	randomServiceHandler RandomServiceHandler,
+	inventoryServiceHandler InventoryServiceHandler,
+) error {
+	var errs error
+
+	s.randomServiceHandler = randomServiceHandler
+	if randomServiceHandler == nil {
+		errs = errors.Join(errs, ErrRandomServiceHandlerIsNil)
+	}
+
+	s.inventoryServiceHandler = inventoryServiceHandler
+	if inventoryServiceHandler == nil {
+		errs = errors.Join(errs, ErrInventoryServiceHandlerIsNil)
+	}
+
+	return errs
+}
+
+// 새로 불러온 테이블 레포지토리로 교체합니다
+func (s *ClickerService) OnTableReload(tableRepo *table.Repository) error {
+	s.tableRepo.Store(tableRepo)
+	return nil
+}
+
+// 진행 중인 몬스터를 가져오며 없으면 새로 생성합니다
+// 랜덤 스트림 예약은 DB 작업이 필요할 수 있으므로 s.mu를 잡지 않은 상태에서 미리 받아 둡니다
+func (s *ClickerService) StartGames(ctx context.Context, uids []string) (map[string]*ClickerMonster, map[string]string, error) {
+	monsters := make(map[string]*ClickerMonster, len(uids))
+	failureUids := make(map[string]string)
+
+	s.mu.Lock()
+	spawnUids := make([]string, 0, len(uids))
+	for _, uid := range uids {
+		if state, ok := s.states[uid]; !ok || state.respawning {
+			spawnUids = append(spawnUids, uid)
+		}
+	}
+	s.mu.Unlock()
+
+	rngs, rngFailures := s.newMonsterRands(ctx, spawnUids)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for _, uid := range uids {
+		state, ok := s.states[uid]
+		if !ok || state.respawning {
+			monster, errCode := s.spawnMonster(rngs[uid], rngFailures[uid])
+			if errCode != "" {
+				failureUids[uid] = errCode
+				continue
+			}
+
+			if !ok {
+				state = &clickerState{checkedAt: now}
+				s.states[uid] = state
+			}
+			state.monster = monster
+			state.respawning = false
+		}
+
+		monster := state.monster
+		monsters[uid] = &monster
+	}
+
+	return monsters, failureUids, nil
+}
+
+// 클릭을 적용하고 처치한 몬스터의 보상을 계정 공용 인벤토리에 지급합니다
+// 보상을 지급한 뒤에 처치를 확정하므로 지급에 실패하면 몬스터와 클릭 허용량을 되돌려 다시 처치할 수 있습니다
+func (s *ClickerService) Click(ctx context.Context, clicks []*ClickerClick) ([]*ClickerClickResult, error) {
+	results := make([]*ClickerClickResult, 0, len(clicks))
+	grants := make([]*inventory.InventoryGrant, 0, len(clicks))
+	grantResults := make([]*ClickerClickResult, 0, len(clicks))
+	killedClicks := make([]*ClickerClick, 0, len(clicks))
+	killedResults := make([]*ClickerClickResult, 0, len(clicks))
+
+	s.mu.Lock()
+	now := time.Now()
+	for _, click := range clicks {
+		result := s.applyClick(click, now)
+		results = append(results, result)
+
+		if result.Killed != nil {
+			killedClicks = append(killedClicks, click)
+			killedResults = append(killedResults, result)
+		}
+
+		if result.Reward != nil {
+			grants = append(grants, &inventory.InventoryGrant{
+				Uid:   click.Uid,
+				Slot:  def.AccountInventorySlot,
+				Index: result.Reward.Index,
+				Count: result.Reward.Count,
+			})
+			grantResults = append(grantResults, result)
+		}
+	}
+	s.mu.Unlock()
+
+	if len(killedResults) == 0 {
+		return results, nil
+	}
+
+	grantFailures := s.grantRewards(ctx, grants, grantResults)
+
+	s.mu.Lock()
+	confirmedResults := make([]*ClickerClickResult, 0, len(killedResults))
+	for i, result := range killedResults {
+		state, ok := s.states[result.Uid]
+		if ok {
+			state.granting = false
+		}
+
+		if errCode, failed := grantFailures[result]; failed {
+			// 보상을 지급하지 못했으므로 처치를 취소합니다
+			result.Killed = nil
+			result.Reward = nil
+			result.ErrorCode = errCode
+			if ok {
+				state.allowance += float64(killedClicks[i].Clicks)
+				monster := state.monster
+				result.Monster = &monster
+			}
+			continue
+		}
+
+		if ok {
+			state.monster.Hp = 0
+			state.respawning = true
+		}
+		confirmedResults = append(confirmedResults, result)
+	}
+	s.mu.Unlock()
+
+	if len(confirmedResults) > 0 {
+		s.respawnMonsters(ctx, confirmedResults)
+	}
+
+	return results, nil
+}
+
+// 처치 보상을 지급하고 지급에 실패한 결과별 오류 코드를 반환합니다 (s.mu를 잡지 않은 상태에서 호출해야 합니다)
+func (s *ClickerService) grantRewards(ctx context.Context, grants []*inventory.InventoryGrant, grantResults []*ClickerClickResult) map[*ClickerClickResult]string {
+	failures := make(map[*ClickerClickResult]string)
+	if len(grants) == 0 {
+		return failures
+	}
+
+	errorCodes, err := s.inventoryServiceHandler.AddItems(ctx, grants)
+	if err != nil {
+		log.Err(err).Msg("클리커 보상 지급 실패")
+		errorCodes = make([]string, len(grants))
+		for i := range errorCodes {
+			errorCodes[i] = CLICKER_REWARD_ERROR
+		}
+	}
+
+	for i, errCode := range errorCodes {
+		if errCode == "" {
+			continue
+		}
+
+		log.Error().Msgf("클리커 보상 지급 실패: %v - %v x %v (%v)", grants[i].Uid, grants[i].Index, grants[i].Count, errCode)
+		failures[grantResults[i]] = errCode
+	}
+
+	return failures
+}
+
+// 클릭 속도를 검증한 뒤 몬스터 체력을 차감합니다 (s.mu를 잡은 상태에서 호출해야 합니다)
+// 몬스터를 처치하면 보상을 지급할 때까지 상태를 granting으로 표시하며, 처치 확정은 Click에서 합니다
+func (s *ClickerService) applyClick(click *ClickerClick, now time.Time) *ClickerClickResult {
+	result := &ClickerClickResult{Uid: click.Uid}
+
+	state, ok := s.states[click.Uid]
+	if !ok || state.respawning || state.granting {
+		result.ErrorCode = CLICKER_MONSTER_NOT_FOUND_ERROR
+		return result
+	}
+
+	// 허용량은 경과 시간만큼 채워지며 clickBurstDuration 이상 누적되지 않습니다
+	elapsed := now.Sub(state.checkedAt)
+	state.allowance = min(state.allowance+elapsed.Seconds()*s.maxClicksPerSecond, clickBurstDuration.Seconds()*s.maxClicksPerSecond)
+	state.checkedAt = now
+
+	monster := state.monster
+	result.Monster = &monster
+
+	if click.Clicks <= 0 {
+		result.ErrorCode = CLICKER_CLICK_COUNT_INVALID_ERROR
+		return result
+	}
+
+	if float64(click.Clicks) > state.allowance {
+		log.Warn().Msgf("클리커 클릭 속도 초과: %v - %v clicks (allowance: %.1f)", click.Uid, click.Clicks, state.allowance)
+		result.ErrorCode = CLICKER_CLICK_RATE_EXCEEDED_ERROR
+		return result
+	}
+
+	state.allowance -= float64(click.Clicks)
+	if state.monster.Hp > click.Clicks {
+		state.monster.Hp -= click.Clicks
+		monster := state.monster
+		result.Monster = &monster
+		return result
+	}
+
+	// 처치 (보상 지급에 실패하면 되돌릴 수 있도록 몬스터 체력은 처치가 확정될 때 차감합니다)
+	killed := state.monster
+	killed.Hp = 0
+	result.Killed = &killed
+	result.Reward = s.getReward(killed.Index)
+	result.Monster = nil
+	state.granting = true
+	return result
+}
+
+// 몬스터를 처치한 유저들의 다음 몬스터를 생성합니다
+// 랜덤 스트림을 s.mu 밖에서 받은 뒤, 그 사이 다른 요청이 먼저 생성한 경우 해당 몬스터를 그대로 사용합니다
+func (s *ClickerService) respawnMonsters(ctx context.Context, killedResults []*ClickerClickResult) {
+	uids := make([]string, 0, len(killedResults))
+	for _, result := range killedResults {
+		uids = append(uids, result.Uid)
+	}
+
+	rngs, rngFailures := s.newMonsterRands(ctx, uids)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, result := range killedResults {
+		state, ok := s.states[result.Uid]
+		if !ok {
+			result.ErrorCode = CLICKER_MONSTER_NOT_FOUND_ERROR
+			continue
+		}
+
+		if state.respawning {
+			next, errCode := s.spawnMonster(rngs[result.Uid], rngFailures[result.Uid])
+			if errCode != "" {
+				delete(s.states, result.Uid)
+				result.ErrorCode = errCode
+				continue
+			}
+
+			state.monster = next
+			state.respawning = false
+		}
+
+		monster := state.monster
+		result.Monster = &monster
+	}
+}
+
+// 몬스터 생성에 사용할 랜덤을 유저별로 받습니다 (DB 작업이 필요할 수 있으므로 s.mu를 잡지 않은 상태에서 호출해야 합니다)
+func (s *ClickerService) newMonsterRands(ctx context.Context, uids []string) (map[string]*rand.Rand, map[string]string) {
+	rngs := make(map[string]*rand.Rand, len(uids))
+	failures := make(map[string]string)
+
+	for _, uid := range uids {
+		if _, ok := rngs[uid]; ok {
+			continue
+		}
+
+		if s.randomServiceHandler == nil {
+			failures[uid] = CLICKER_UNKNOWN_ERROR
+			continue
+		}
+
+		rng, err := s.randomServiceHandler.NewClickerRand(ctx, uid)
+		if err != nil {
+			log.Err(err).Msg("클리커 랜덤 생성 실패")
+			failures[uid] = CLICKER_MONSTER_SPAWN_ERROR
+			continue
+		}
+		rngs[uid] = rng
+	}
+
+	return rngs, failures
+}
+
+// 몬스터 테이블의 확률(Prob)로 몬스터를 생성합니다 (랜덤을 받지 못한 경우 rngErrCode를 반환합니다)
+func (s *ClickerService) spawnMonster(rng *rand.Rand, rngErrCode string) (ClickerMonster, string) {
+	if rngErrCode != "" {
+		return ClickerMonster{}, rngErrCode
+	}
+	if rng == nil {
+		return ClickerMonster{}, CLICKER_MONSTER_SPAWN_ERROR
+	}
+
+	monsters, ok := table.Get[*table.ClickerMonsterTable](s.tableRepo.Load())
+	if !ok {
+		log.Error().Msg("클리커 몬스터 테이블이 없습니다")
+		return ClickerMonster{}, CLICKER_MONSTER_SPAWN_ERROR
+	}
+
+	weightedPicker := util.NewWeightedPicker[table.ClickerMonsterRecord, float64](rng)
+	for record := range monsters.All() {
+		if record.Hp > 0 {
+			weightedPicker.Add(record, record.Prob)
+		}
+	}
+
+	record, err := weightedPicker.Pick()
+	if err != nil {
+		log.Err(err).Msg("클리커 몬스터 생성 실패")
+		return ClickerMonster{}, CLICKER_MONSTER_SPAWN_ERROR
+	}
+
+	return ClickerMonster{
+		Index:   record.Index,
+		ModelID: record.ModelID,
+		Hp:      record.Hp,
+		MaxHp:   record.Hp,
+	}, ""
+}
+
+// 몬스터 처치 보상을 구합니다 (보상이 없으면 nil)
+func (s *ClickerService) getReward(monsterIndex string) *ClickerReward {
+	monsters, ok := table.Get[*table.ClickerMonsterTable](s.tableRepo.Load())
+	if !ok {
+		return nil
+	}
+
+	record, ok := monsters.Get(monsterIndex)
+	if !ok || record.ItemIndex == "" || record.ItemCount <= 0 {
+		return nil
+	}
+
+	return &ClickerReward{
+		Index: record.ItemIndex,
+		Count: record.ItemCount,
+	}
+}
+
+func (s *ClickerService) janitor(ctx context.Context) {
+	ticker := time.NewTicker(clickerJanitorInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.mu.Lock()
+			for uid, state := range s.states {
+				if now.Sub(state.checkedAt) > clickerStateTTL {
+					delete(s.states, uid)
+				}
+			}
+			s.mu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package clicker
+
+import (
+	"MScannot206/pkg/inventory"
+	"MScannot206/shared/def"
+	"MScannot206/shared/table"
+	"context"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testRandomServiceHandler struct{}
+
+func (h *testRandomServiceHandler) NewClickerRand(ctx context.Context, uid string) (*rand.Rand, error) {
+	return rand.New(rand.NewPCG(1, 2)), nil
+}
+
+type testInventoryServiceHandler struct {
+	mu     sync.Mutex
+	grants []*inventory.InventoryGrant
+
+	// 설정되어 있으면 지급하지 않고 모든 지급을 이 오류 코드로 실패시킵니다
+	errCode string
+}
+
+func (h *testInventoryServiceHandler) AddItems(ctx context.Context, grants []*inventory.InventoryGrant) ([]string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	errorCodes := make([]string, len(grants))
+	if h.errCode != "" {
+		for i := range errorCodes {
+			errorCodes[i] = h.errCode
+		}
+		return errorCodes, nil
+	}
+
+	h.grants = append(h.grants, grants...)
+	return errorCodes, nil
+}
+
+// 데이터 테이블을 복사한 뒤 클리커 몬스터 테이블만 바꿔서 불러옵니다
+func loadTestTables(t *testing.T, clickerMonsterCsv string) *table.Repository {
+	t.Helper()
+
+	dataPath, err := filepath.Abs("../../../data")
+	if err != nil {
+		t.Fatalf("failed to get absolute path: %v", err)
+	}
+
+	tempDir := t.TempDir()
+	for _, reg := range table.Registrations() {
+		data, err := os.ReadFile(filepath.Join(dataPath, reg.CsvFile))
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", reg.CsvFile, err)
+		}
+		if reg.CsvFile == "ClickerMonster.csv" {
+			data = []byte(clickerMonsterCsv)
+		}
+		if err := os.WriteFile(filepath.Join(tempDir, reg.CsvFile), data, 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", reg.CsvFile, err)
+		}
+	}
+
+	r := &table.Repository{}
+	if err := r.Load(tempDir); err != nil {
+		t.Fatalf("failed to load repository: %v", err)
+	}
+	if err := r.Validate(); err != nil {
+		t.Fatalf("failed to validate repository: %v", err)
+	}
+	return r
+}
+
+func newTestClickerService(t *testing.T, clickerMonsterCsv string) (*ClickerService, *testInventoryServiceHandler) {
+	t.Helper()
+
+	s, err := NewClickerService(DefaultMaxClicksPerSecond)
+	if err != nil {
+		t.Fatalf("failed to create clicker service: %v", err)
+	}
+
+	inventoryHandler := &testInventoryServiceHandler{}
+	if err := s.SetRepositories(loadTestTables(t, clickerMonsterCsv)); err != nil {
+		t.Fatalf("failed to set repositories: %v", err)
+	}
+	if err := s.SetHandlers(&testRandomServiceHandler{}, inventoryHandler); err != nil {
+		t.Fatalf("failed to set handlers: %v", err)
+	}
+	return s, inventoryHandler
+}
+
+// 클릭 허용량이 모두 채워지도록 마지막 계산 시각을 되돌립니다
+func fillAllowance(s *ClickerService, uid string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.states[uid].checkedAt = time.Now().Add(-clickBurstDuration)
+}
+
+func TestClickerKillReward(t *testing.T) {
+	s, inventoryHandler := newTestClickerService(t, "Index,Prob,ItemIndex,ItemCount,Hp,ModelID\n1,1,cap-644,3,5,m1\n")
+	ctx := context.Background()
+
+	monsters, failures, err := s.StartGames(ctx, []string{"user1"})
+	if err != nil {
+		t.Fatalf("failed to start game: %v", err)
+	}
+	if len(failures) != 0 {
+		t.Fatalf("unexpected start failures: %v", failures)
+	}
+	if monster := monsters["user1"]; monster == nil || monster.Index != "1" || monster.Hp != 5 {
+		t.Fatalf("unexpected monster: %+v", monster)
+	}
+	fillAllowance(s, "user1")
+
+	results, err := s.Click(ctx, []*ClickerClick{{Uid: "user1", Clicks: 5}})
+	if err != nil {
+		t.Fatalf("failed to click: %v", err)
+	}
+
+	result := results[0]
+	if result.ErrorCode != "" {
+		t.Fatalf("unexpected click error: %v", result.ErrorCode)
+	}
+	if result.Killed == nil || result.Killed.Index != "1" {
+		t.Fatalf("expected monster to be killed: %+v", result.Killed)
+	}
+	if result.Reward == nil || result.Reward.Index != "cap-644" || result.Reward.Count != 3 {
+		t.Fatalf("unexpected reward: %+v", result.Reward)
+	}
+	if result.Monster == nil || result.Monster.Hp != result.Monster.MaxHp {
+		t.Fatalf("expected next monster to be spawned: %+v", result.Monster)
+	}
+
+	if len(inventoryHandler.grants) != 1 {
+		t.Fatalf("expected one grant, got %d", len(inventoryHandler.grants))
+	}
+	grant := inventoryHandler.grants[0]
+	if grant.Uid != "user1" || grant.Slot != def.AccountInventorySlot || grant.Index != "cap-644" || grant.Count != 3 {
+		t.Errorf("unexpected grant: %+v", grant)
+	}
+}
+
+func TestClickerKillWithoutReward(t *testing.T) {
+	s, inventoryHandler := newTestClickerService(t, "Index,Prob,ItemIndex,ItemCount,Hp,ModelID\n1,1,,,5,m1\n")
+	ctx := context.Background()
+
+	if _, _, err := s.StartGames(ctx, []string{"user1"}); err != nil {
+		t.Fatalf("failed to start game: %v", err)
+	}
+	fillAllowance(s, "user1")
+
+	// 같은 요청에서 처치한 뒤의 클릭은 다음 몬스터가 생성되기 전이므로 적용되지 않습니다
+	results, err := s.Click(ctx, []*ClickerClick{{Uid: "user1", Clicks: 5}, {Uid: "user1", Clicks: 1}})
+	if err != nil {
+		t.Fatalf("failed to click: %v", err)
+	}
+
+	if results[0].Killed == nil || results[0].Reward != nil || results[0].Monster == nil {
+		t.Errorf("unexpected kill result: %+v", results[0])
+	}
+	if results[1].ErrorCode != CLICKER_MONSTER_NOT_FOUND_ERROR {
+		t.Errorf("expected monster not found error, got %q", results[1].ErrorCode)
+	}
+	if len(inventoryHandler.grants) != 0 {
+		t.Errorf("expected no grants, got %d", len(inventoryHandler.grants))
+	}
+}
+
+func TestClickerRewardFailureKeepsMonster(t *testing.T) {
+	s, inventoryHandler := newTestClickerService(t, "Index,Prob,ItemIndex,ItemCount,Hp,ModelID\n1,1,cap-644,3,5,m1\n")
+	ctx := context.Background()
+
+	if _, _, err := s.StartGames(ctx, []string{"user1"}); err != nil {
+		t.Fatalf("failed to start game: %v", err)
+	}
+	fillAllowance(s, "user1")
+
+	// 보상 지급에 실패하면 처치를 취소하고 몬스터를 그대로 둡니다
+	inventoryHandler.errCode = inventory.INVENTORY_DB_WRITE_ERROR
+	results, err := s.Click(ctx, []*ClickerClick{{Uid: "user1", Clicks: 5}})
+	if err != nil {
+		t.Fatalf("failed to click: %v", err)
+	}
+
+	result := results[0]
+	if result.ErrorCode != inventory.INVENTORY_DB_WRITE_ERROR {
+		t.Fatalf("expected grant error, got %q", result.ErrorCode)
+	}
+	if result.Killed != nil || result.Reward != nil {
+		t.Fatalf("expected kill to be canceled: %+v", result)
+	}
+	if result.Monster == nil || result.Monster.Hp != 5 {
+		t.Fatalf("expected monster to keep its hp: %+v", result.Monster)
+	}
+
+	// 되돌린 클릭 허용량으로 다시 처치하면 보상을 지급합니다
+	inventoryHandler.errCode = ""
+	results, err = s.Click(ctx, []*ClickerClick{{Uid: "user1", Clicks: 5}})
+	if err != nil {
+		t.Fatalf("failed to click: %v", err)
+	}
+	if results[0].ErrorCode != "" || results[0].Killed == nil || results[0].Reward == nil {
+		t.Fatalf("expected retry to kill with reward: %+v", results[0])
+	}
+	if len(inventoryHandler.grants) != 1 {
+		t.Errorf("expected one grant, got %d", len(inventoryHandler.grants))
+	}
+}
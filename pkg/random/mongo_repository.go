@@ -0,0 +1,64 @@
+package random
+
+import (
+	"MScannot206/shared"
+	"MScannot206/shared/entity"
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var ErrRandomMongoRepositoryIsNil = errors.New("random mongo repository is null")
+
+func NewRandomMongoRepository(
+	ctx context.Context,
+	client *mongo.Client,
+	dbName string,
+) (*RandomMongoRepository, error) {
+	if client == nil {
+		return nil, errors.New("mongo client is null")
+	}
+
+	return &RandomMongoRepository{
+		client: client,
+		random: client.Database(dbName).Collection(shared.Random),
+	}, nil
+}
+
+type RandomMongoRepository struct {
+	client *mongo.Client
+	random *mongo.Collection
+}
+
+// 스트림의 추첨 번호를 count개 예약합니다. 스트림이 없으면 seed로 새로 생성합니다
+// 반환된 엔티티의 Draws는 예약 후의 값이므로 예약된 범위는 [Draws-count, Draws)입니다
+func (r *RandomMongoRepository) ReserveDraws(ctx context.Context, name string, seed int64, count int64) (*entity.GameRandom, error) {
+	filter := bson.M{
+		"_id": name,
+	}
+
+	update := bson.M{
+		"$setOnInsert": bson.M{
+			"seed": seed,
+		},
+		"$inc": bson.M{
+			"draws": count,
+		},
+		"$set": bson.M{
+			"updated_at": time.Now().UTC(),
+		},
+	}
+
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var gameRandom entity.GameRandom
+	if err := r.random.FindOneAndUpdate(ctx, filter, update, opts).Decode(&gameRandom); err != nil {
+		return nil, err
+	}
+
+	return &gameRandom, nil
+}
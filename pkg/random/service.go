@@ -2,21 +2,63 @@ package random
 
 import (
 	"context"
-	"math/rand/v2"
-	"time"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	mathrand "math/rand/v2"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+// 랜덤 스트림 이름
+const (
+	// 캐릭터 생성
+	StreamCharacterCreate = "character_create"
+
+	// 클리커 미니게임
+	StreamMinigameClicker = "minigame_clicker"
+
+	// 보상 뽑기
+	StreamReward = "reward"
 )
 
-func NewRandomService() (*RandomService, error) {
-	return &RandomService{}, nil
+// 한 번에 예약할 추첨 번호 개수
+const drawReserveCount = 1000
+
+func NewRandomService(logDraws bool) (*RandomService, error) {
+	return &RandomService{
+		logDraws: logDraws,
+		streams:  make(map[string]*randomStream),
+	}, nil
 }
 
+// 랜덤 서비스는 이름별 랜덤 스트림을 관리하는 서비스입니다
+// 추첨마다 스트림 시드와 추첨 번호로 새 *rand.Rand를 만들어 주므로 동시에 사용해도 안전하며,
+// 같은 시드와 추첨 번호로 NewReplayRand를 호출하면 같은 결과를 다시 얻을 수 있습니다
 type RandomService struct {
-	characterCreateSeed *rand.Rand
+	randomRepo *RandomMongoRepository
+
+	// 추첨마다 시드와 추첨 번호를 로그로 남길지 여부
+	logDraws bool
+
+	streams map[string]*randomStream
+	mu      sync.Mutex
+}
+
+// 랜덤 스트림 상태
+type randomStream struct {
+	name string
+	seed uint64
+
+	// 예약된 추첨 번호 범위 [next, end)
+	next uint64
+	end  uint64
+
+	mu sync.Mutex
 }
 
 func (s *RandomService) Start(ctx context.Context) error {
-	seed := uint64(time.Now().UnixNano())
-	s.characterCreateSeed = rand.New(rand.NewPCG(seed, seed))
 	return nil
 }
 
@@ -24,6 +66,97 @@ func (s *RandomService) Stop(ctx context.Context) error {
 	return nil
 }
 
-func (s *RandomService) GetCharacterCreateSeed() *rand.Rand {
-	return s.characterCreateSeed
+func (s *RandomService) SetRepositories(randomRepo *RandomMongoRepository) error {
+	s.randomRepo = randomRepo
+	if randomRepo == nil {
+		return ErrRandomMongoRepositoryIsNil
+	}
+	return nil
+}
+
+// 캐릭터 생성 스트림에서 추첨합니다
+func (s *RandomService) NewCharacterCreateRand(ctx context.Context, uid string) (*mathrand.Rand, error) {
+	return s.NewRand(ctx, StreamCharacterCreate, uid)
+}
+
+// 클리커 미니게임 스트림에서 추첨합니다
+func (s *RandomService) NewClickerRand(ctx context.Context, uid string) (*mathrand.Rand, error) {
+	return s.NewRand(ctx, StreamMinigameClicker, uid)
+}
+
+// 보상 뽑기 스트림에서 추첨합니다
+func (s *RandomService) NewRewardRand(ctx context.Context, uid string) (*mathrand.Rand, error) {
+	return s.NewRand(ctx, StreamReward, uid)
+}
+
+// 스트림에서 다음 추첨 번호를 할당하고 해당 추첨 전용 *rand.Rand를 만듭니다
+// subject는 추첨 로그에 함께 남길 대상(유저 고유 ID 등)입니다
+func (s *RandomService) NewRand(ctx context.Context, name string, subject string) (*mathrand.Rand, error) {
+	stream := s.getStream(name)
+
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	if stream.next >= stream.end {
+		if err := s.reserve(ctx, stream); err != nil {
+			return nil, err
+		}
+	}
+
+	draw := stream.next
+	stream.next++
+
+	if s.logDraws {
+		log.Info().
+			Str("stream", stream.name).
+			Str("subject", subject).
+			Uint64("seed", stream.seed).
+			Uint64("draw", draw).
+			Msg("랜덤 스트림 추첨")
+	}
+
+	return NewReplayRand(stream.seed, draw), nil
+}
+
+func (s *RandomService) getStream(name string) *randomStream {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stream, ok := s.streams[name]
+	if !ok {
+		stream = &randomStream{name: name}
+		s.streams[name] = stream
+	}
+	return stream
+}
+
+// 추첨 번호를 DB에 예약합니다. 재시작하거나 여러 서버가 같은 스트림을 사용해도 추첨 번호는 중복되지 않습니다
+func (s *RandomService) reserve(ctx context.Context, stream *randomStream) error {
+	if s.randomRepo == nil {
+		return ErrRandomMongoRepositoryIsNil
+	}
+
+	var seedBytes [8]byte
+	if _, err := rand.Read(seedBytes[:]); err != nil {
+		return err
+	}
+
+	gameRandom, err := s.randomRepo.ReserveDraws(ctx, stream.name, int64(binary.LittleEndian.Uint64(seedBytes[:])), drawReserveCount)
+	if err != nil {
+		log.Err(err).Msgf("랜덤 스트림 추첨 번호 예약 실패: %v", stream.name)
+		return err
+	}
+
+	stream.seed = uint64(gameRandom.Seed)
+	stream.end = uint64(gameRandom.Draws)
+	stream.next = stream.end - drawReserveCount
+	return nil
+}
+
+// 스트림 시드와 추첨 번호로 추첨 전용 *rand.Rand를 만듭니다
+func NewReplayRand(seed uint64, draw uint64) *mathrand.Rand {
+	var buf [16]byte
+	binary.LittleEndian.PutUint64(buf[:8], seed)
+	binary.LittleEndian.PutUint64(buf[8:], draw)
+	return mathrand.New(mathrand.NewChaCha8(sha256.Sum256(buf[:])))
 }
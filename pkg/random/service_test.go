@@ -0,0 +1,216 @@
+package random
+
+import (
+	"MScannot206/shared/util"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 설정되어 있으면 MongoDB로 추첨 번호 예약 테스트를 실행합니다
+const testMongoUriEnv = "MSCANNOT_TEST_MONGO_URI"
+
+// DB에서 [next, end) 범위를 예약받은 것처럼 스트림 상태를 채웁니다
+func newReservedService(t *testing.T, name string, seed uint64, next uint64, end uint64) *RandomService {
+	t.Helper()
+
+	s, err := NewRandomService(false)
+	if err != nil {
+		t.Fatalf("failed to create random service: %v", err)
+	}
+	s.streams[name] = &randomStream{name: name, seed: seed, next: next, end: end}
+	return s
+}
+
+func sample(rng *rand.Rand) [4]uint64 {
+	return [4]uint64{rng.Uint64(), rng.Uint64(), rng.Uint64(), rng.Uint64()}
+}
+
+func TestNewReplayRand(t *testing.T) {
+	if sample(NewReplayRand(42, 7)) != sample(NewReplayRand(42, 7)) {
+		t.Errorf("expected same seed and draw to produce same sequence")
+	}
+	if sample(NewReplayRand(42, 7)) == sample(NewReplayRand(42, 8)) {
+		t.Errorf("expected different draws to produce different sequences")
+	}
+	if sample(NewReplayRand(42, 7)) == sample(NewReplayRand(43, 7)) {
+		t.Errorf("expected different seeds to produce different sequences")
+	}
+}
+
+func TestNewRandReplaysLoggedDraw(t *testing.T) {
+	var buf bytes.Buffer
+	prev := log.Logger
+	log.Logger = zerolog.New(&buf)
+	t.Cleanup(func() {
+		log.Logger = prev
+	})
+
+	s := newReservedService(t, StreamReward, 0x5eed, 3000, 4000)
+	s.logDraws = true
+
+	rng, err := s.NewRewardRand(context.Background(), "user1")
+	if err != nil {
+		t.Fatalf("failed to create rand: %v", err)
+	}
+	got := sample(rng)
+
+	var logged struct {
+		Stream  string `json:"stream"`
+		Subject string `json:"subject"`
+		Seed    uint64 `json:"seed"`
+		Draw    uint64 `json:"draw"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &logged); err != nil {
+		t.Fatalf("failed to parse draw log %q: %v", buf.String(), err)
+	}
+	if logged.Stream != StreamReward || logged.Subject != "user1" {
+		t.Errorf("unexpected draw log: %+v", logged)
+	}
+
+	// 로그에 남은 시드와 추첨 번호만으로 같은 결과를 다시 얻을 수 있습니다
+	if replayed := sample(NewReplayRand(logged.Seed, logged.Draw)); replayed != got {
+		t.Errorf("expected replay to match, got %v and %v", replayed, got)
+	}
+	if logged.Seed != 0x5eed || logged.Draw != 3000 {
+		t.Errorf("expected seed %v draw 3000, got seed %v draw %v", 0x5eed, logged.Seed, logged.Draw)
+	}
+}
+
+func TestNewRandUsesReservedDraws(t *testing.T) {
+	ctx := context.Background()
+	s := newReservedService(t, StreamCharacterCreate, 42, 5000, 5000+drawReserveCount)
+
+	// 예약한 범위의 추첨 번호를 순서대로 한 번씩 사용합니다
+	for i := range uint64(drawReserveCount) {
+		rng, err := s.NewCharacterCreateRand(ctx, "user1")
+		if err != nil {
+			t.Fatalf("draw %d: failed to create rand: %v", i, err)
+		}
+		if sample(rng) != sample(NewReplayRand(42, 5000+i)) {
+			t.Fatalf("draw %d: expected draw number %d", i, 5000+i)
+		}
+	}
+
+	// 예약한 범위를 모두 사용하면 DB에 다시 예약해야 합니다
+	if _, err := s.NewCharacterCreateRand(ctx, "user1"); !errors.Is(err, ErrRandomMongoRepositoryIsNil) {
+		t.Errorf("expected reservation after %d draws, got %v", drawReserveCount, err)
+	}
+}
+
+func TestNewRandConcurrent(t *testing.T) {
+	const (
+		workers = 16
+		draws   = 200
+	)
+
+	ctx := context.Background()
+	s := newReservedService(t, StreamMinigameClicker, 42, 0, workers*draws)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range draws {
+				rng, err := s.NewClickerRand(ctx, "user1")
+				if err != nil {
+					errs <- err
+					return
+				}
+
+				picker := util.NewWeightedPicker[string, int](rng)
+				picker.Add("a", 1)
+				picker.Add("b", 3)
+				if _, err := picker.Pick(); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("concurrent pick failed: %v", err)
+	}
+
+	// 동시에 추첨해도 추첨 번호는 중복 없이 모두 사용됩니다
+	stream := s.streams[StreamMinigameClicker]
+	if stream.next != workers*draws {
+		t.Errorf("expected %d draws to be used, got %d", workers*draws, stream.next)
+	}
+}
+
+func TestReserveDrawsMongo(t *testing.T) {
+	uri := os.Getenv(testMongoUriEnv)
+	if uri == "" {
+		t.Skipf("%v is not set", testMongoUriEnv)
+	}
+
+	ctx := context.Background()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		t.Fatalf("failed to connect mongo: %v", err)
+	}
+	t.Cleanup(func() {
+		client.Disconnect(context.Background())
+	})
+
+	dbName := "random_test_" + primitive.NewObjectID().Hex()
+	t.Cleanup(func() {
+		client.Database(dbName).Drop(context.Background())
+	})
+
+	newService := func() *RandomService {
+		repo, err := NewRandomMongoRepository(ctx, client, dbName)
+		if err != nil {
+			t.Fatalf("failed to create random repository: %v", err)
+		}
+		s, err := NewRandomService(false)
+		if err != nil {
+			t.Fatalf("failed to create random service: %v", err)
+		}
+		if err := s.SetRepositories(repo); err != nil {
+			t.Fatalf("failed to set repositories: %v", err)
+		}
+		return s
+	}
+
+	first := newService()
+	if _, err := first.NewRewardRand(ctx, "user1"); err != nil {
+		t.Fatalf("failed to create rand: %v", err)
+	}
+	seed := first.streams[StreamReward].seed
+
+	// 재시작한 서버도 저장된 시드를 사용하고 이전 예약 다음 범위부터 추첨합니다
+	restarted := newService()
+	rng, err := restarted.NewRewardRand(ctx, "user1")
+	if err != nil {
+		t.Fatalf("failed to create rand: %v", err)
+	}
+
+	stream := restarted.streams[StreamReward]
+	if stream.seed != seed {
+		t.Errorf("expected persisted seed %v, got %v", seed, stream.seed)
+	}
+	if stream.end != 2*drawReserveCount {
+		t.Errorf("expected reservation to end at %d, got %d", 2*drawReserveCount, stream.end)
+	}
+	if sample(rng) != sample(NewReplayRand(seed, drawReserveCount)) {
+		t.Errorf("expected first draw after restart to use draw number %d", drawReserveCount)
+	}
+}
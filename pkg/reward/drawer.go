@@ -0,0 +1,182 @@
+package reward
+
+import (
+	"MScannot206/shared/entity"
+	"MScannot206/shared/table"
+	"MScannot206/shared/types"
+	"MScannot206/shared/util"
+	"errors"
+	"math/rand/v2"
+	"slices"
+)
+
+var ErrRewardGroupNotFound = errors.New("reward group not found")
+var ErrRewardGroupTooDeep = errors.New("reward group nesting is too deep")
+var ErrRewardDrawCountInvalid = errors.New("reward draw count is invalid")
+
+// 중첩 보상 그룹의 최대 깊이
+const maxRewardGroupDepth = 8
+
+// 보상 테이블과 유저 상태로 보상을 뽑습니다
+// 뽑는 동안 유저 상태(천장 카운터, 덱)를 직접 갱신하므로 저장은 호출한 쪽에서 해야 합니다
+type rewardDrawer struct {
+	groups  *table.RewardGroupTable
+	entries *table.RewardGroupEntryTable
+
+	state *entity.UserReward
+	rng   *rand.Rand
+
+	// 천장으로 지급된 횟수
+	pityDraws int
+}
+
+func newRewardDrawer(tableRepo *table.Repository, state *entity.UserReward, rng *rand.Rand) (*rewardDrawer, error) {
+	groups, err := table.Lookup[*table.RewardGroupTable](tableRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := table.Lookup[*table.RewardGroupEntryTable](tableRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	if state.Pity == nil {
+		state.Pity = make(map[string]int64)
+	}
+	if state.Decks == nil {
+		state.Decks = make(map[string][]string)
+	}
+
+	return &rewardDrawer{
+		groups:  groups,
+		entries: entries,
+		state:   state,
+		rng:     rng,
+	}, nil
+}
+
+// 보상 그룹에서 보상을 한 번 뽑습니다 (중첩 그룹은 재귀적으로 뽑습니다)
+func (d *rewardDrawer) draw(groupIndex string, depth int) ([]*RewardItem, error) {
+	if depth > maxRewardGroupDepth {
+		return nil, ErrRewardGroupTooDeep
+	}
+
+	group, ok := d.groups.Get(groupIndex)
+	if !ok {
+		return nil, ErrRewardGroupNotFound
+	}
+
+	entries := d.entries.GetByGroupIndex(groupIndex)
+
+	var picked []table.RewardGroupEntryRecord
+	if group.PityCount > 0 && d.state.Pity[groupIndex] >= group.PityCount-1 {
+		// 천장: 천장 보상 중에서 가중치로 하나를 뽑습니다
+		d.pityDraws++
+		picked = d.pickWeighted(entries, func(entry table.RewardGroupEntryRecord) bool {
+			return entry.Pity
+		})
+		if group.PickType == types.RewardPickType_Deck {
+			d.removeFromDeck(groupIndex, picked)
+		}
+	} else {
+		switch group.PickType {
+		case types.RewardPickType_Weighted:
+			picked = d.pickWeighted(entries, nil)
+		case types.RewardPickType_Independent:
+			picked = d.pickIndependent(entries, group.MaxWeight)
+		case types.RewardPickType_Deck:
+			picked = d.pickDeck(groupIndex, entries)
+		}
+	}
+
+	if group.PityCount > 0 {
+		if slices.ContainsFunc(picked, func(entry table.RewardGroupEntryRecord) bool { return entry.Pity }) {
+			d.state.Pity[groupIndex] = 0
+		} else {
+			d.state.Pity[groupIndex]++
+		}
+	}
+
+	var items []*RewardItem
+	for _, entry := range picked {
+		if entry.ItemIndex != "" && entry.ItemCount > 0 {
+			items = append(items, &RewardItem{Index: entry.ItemIndex, Count: entry.ItemCount})
+		}
+
+		if entry.SubGroupIndex != "" {
+			subItems, err := d.draw(entry.SubGroupIndex, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, subItems...)
+		}
+	}
+
+	return items, nil
+}
+
+// 가중치에 따라 하나를 뽑습니다 (filter가 nil이 아니면 filter를 만족하는 보상 중에서 뽑습니다)
+func (d *rewardDrawer) pickWeighted(entries []table.RewardGroupEntryRecord, filter func(table.RewardGroupEntryRecord) bool) []table.RewardGroupEntryRecord {
+	weightedPicker := util.NewWeightedPicker[table.RewardGroupEntryRecord, float64](d.rng)
+	for _, entry := range entries {
+		if filter == nil || filter(entry) {
+			weightedPicker.Add(entry, entry.Weight)
+		}
+	}
+
+	entry, err := weightedPicker.Pick()
+	if err != nil {
+		return nil
+	}
+	return []table.RewardGroupEntryRecord{entry}
+}
+
+// 각 보상마다 독립적으로 확률을 굴려 당첨된 보상을 모두 뽑습니다
+func (d *rewardDrawer) pickIndependent(entries []table.RewardGroupEntryRecord, maxWeight float64) []table.RewardGroupEntryRecord {
+	independentPicker := util.NewIndependentPicker[table.RewardGroupEntryRecord, float64](maxWeight, d.rng)
+	for _, entry := range entries {
+		independentPicker.Add(entry, entry.Weight)
+	}
+	return independentPicker.Pick()
+}
+
+// 유저 덱에 남은 보상 중에서 가중치에 따라 하나를 뽑고 덱에서 제거합니다 (덱이 비면 다시 채웁니다)
+func (d *rewardDrawer) pickDeck(groupIndex string, entries []table.RewardGroupEntryRecord) []table.RewardGroupEntryRecord {
+	deck := d.state.Decks[groupIndex]
+
+	deckPicker := util.NewDeckPicker[table.RewardGroupEntryRecord, float64](d.rng)
+	for _, entry := range entries {
+		if slices.Contains(deck, entry.Index) {
+			deckPicker.Add(entry, entry.Weight)
+		}
+	}
+
+	entry, ok := deckPicker.Pick()
+	if !ok {
+		// 덱이 비었거나 테이블 변경으로 남은 보상이 모두 사라진 경우 새 덱으로 시작합니다
+		deck = deck[:0]
+		deckPicker = util.NewDeckPicker[table.RewardGroupEntryRecord, float64](d.rng)
+		for _, entry := range entries {
+			if entry.Weight > 0 {
+				deck = append(deck, entry.Index)
+				deckPicker.Add(entry, entry.Weight)
+			}
+		}
+		d.state.Decks[groupIndex] = deck
+
+		if entry, ok = deckPicker.Pick(); !ok {
+			return nil
+		}
+	}
+
+	picked := []table.RewardGroupEntryRecord{entry}
+	d.removeFromDeck(groupIndex, picked)
+	return picked
+}
+
+func (d *rewardDrawer) removeFromDeck(groupIndex string, picked []table.RewardGroupEntryRecord) {
+	d.state.Decks[groupIndex] = slices.DeleteFunc(d.state.Decks[groupIndex], func(index string) bool {
+		return slices.ContainsFunc(picked, func(entry table.RewardGroupEntryRecord) bool { return entry.Index == index })
+	})
+}
@@ -0,0 +1,208 @@
+package reward
+
+import (
+	"MScannot206/shared/entity"
+	"MScannot206/shared/table"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const testRewardGroupCsv = `Index,PickType,MaxWeight,PityCount,desc
+pity,weighted,0,3,
+lucky,weighted,0,5,
+deck,deck,0,0,
+`
+
+const testRewardGroupEntryCsv = `Index,GroupIndex,ItemIndex,ItemCount,SubGroupIndex,Weight,Pity
+pity-normal,pity,cap-644,1,,1000000,FALSE
+pity-rare,pity,cap-3028,1,,0.000001,TRUE
+lucky-rare,lucky,cap-3028,1,,1,TRUE
+deck-1,deck,cap-644,1,,1,FALSE
+deck-2,deck,cap-1554,1,,1,FALSE
+deck-3,deck,cap-3028,1,,1,FALSE
+`
+
+// 데이터 테이블을 복사한 뒤 보상 테이블만 바꿔서 불러옵니다
+func loadTestTables(t *testing.T, groupCsv string, entryCsv string) *table.Repository {
+	t.Helper()
+
+	dataPath, err := filepath.Abs("../../data")
+	if err != nil {
+		t.Fatalf("failed to get absolute path: %v", err)
+	}
+
+	tempDir := t.TempDir()
+	for _, reg := range table.Registrations() {
+		data, err := os.ReadFile(filepath.Join(dataPath, reg.CsvFile))
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", reg.CsvFile, err)
+		}
+		switch reg.CsvFile {
+		case "RewardGroup.csv":
+			data = []byte(groupCsv)
+		case "RewardGroupEntry.csv":
+			data = []byte(entryCsv)
+		}
+		if err := os.WriteFile(filepath.Join(tempDir, reg.CsvFile), data, 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", reg.CsvFile, err)
+		}
+	}
+
+	r := &table.Repository{}
+	if err := r.Load(tempDir); err != nil {
+		t.Fatalf("failed to load repository: %v", err)
+	}
+	return r
+}
+
+func newTestDrawer(t *testing.T, tableRepo *table.Repository, state *entity.UserReward) *rewardDrawer {
+	t.Helper()
+
+	d, err := newRewardDrawer(tableRepo, state, rand.New(rand.NewPCG(1, 2)))
+	if err != nil {
+		t.Fatalf("failed to create drawer: %v", err)
+	}
+	return d
+}
+
+func drawOne(t *testing.T, d *rewardDrawer, groupIndex string) string {
+	t.Helper()
+
+	items, err := d.draw(groupIndex, 0)
+	if err != nil {
+		t.Fatalf("failed to draw %v: %v", groupIndex, err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item from %v, got %d", groupIndex, len(items))
+	}
+	return items[0].Index
+}
+
+func TestDrawPity(t *testing.T) {
+	tableRepo := loadTestTables(t, testRewardGroupCsv, testRewardGroupEntryCsv)
+	state := &entity.UserReward{Uid: "user1"}
+	d := newTestDrawer(t, tableRepo, state)
+
+	// PityCount가 3이면 천장 보상 없이 2번 뽑은 다음 3번째에 천장 보상을 지급합니다
+	want := []string{"cap-644", "cap-644", "cap-3028", "cap-644", "cap-644", "cap-3028"}
+	wantPity := []int64{1, 2, 0, 1, 2, 0}
+	for i := range want {
+		if got := drawOne(t, d, "pity"); got != want[i] {
+			t.Fatalf("draw %d: expected %v, got %v", i+1, want[i], got)
+		}
+		if state.Pity["pity"] != wantPity[i] {
+			t.Fatalf("draw %d: expected pity counter %d, got %d", i+1, wantPity[i], state.Pity["pity"])
+		}
+	}
+	if d.pityDraws != 2 {
+		t.Errorf("expected 2 pity draws, got %d", d.pityDraws)
+	}
+}
+
+func TestDrawPityReset(t *testing.T) {
+	tableRepo := loadTestTables(t, testRewardGroupCsv, testRewardGroupEntryCsv)
+	state := &entity.UserReward{
+		Uid:  "user1",
+		Pity: map[string]int64{"lucky": 2},
+	}
+	d := newTestDrawer(t, tableRepo, state)
+
+	// 천장 전에 천장 보상이 나오면 천장으로 지급하지 않고 카운터만 초기화합니다
+	if got := drawOne(t, d, "lucky"); got != "cap-3028" {
+		t.Fatalf("expected cap-3028, got %v", got)
+	}
+	if state.Pity["lucky"] != 0 {
+		t.Errorf("expected pity counter to reset, got %d", state.Pity["lucky"])
+	}
+	if d.pityDraws != 0 {
+		t.Errorf("expected no pity draws, got %d", d.pityDraws)
+	}
+}
+
+func TestDrawDeck(t *testing.T) {
+	tableRepo := loadTestTables(t, testRewardGroupCsv, testRewardGroupEntryCsv)
+	state := &entity.UserReward{Uid: "user1"}
+	d := newTestDrawer(t, tableRepo, state)
+
+	// 덱이 빌 때까지 같은 보상은 다시 나오지 않습니다
+	var picked []string
+	for range 3 {
+		picked = append(picked, drawOne(t, d, "deck"))
+	}
+	slices.Sort(picked)
+	if want := []string{"cap-1554", "cap-3028", "cap-644"}; !slices.Equal(picked, want) {
+		t.Fatalf("expected each deck entry once, got %v", picked)
+	}
+	if len(state.Decks["deck"]) != 0 {
+		t.Fatalf("expected deck to be exhausted, got %v", state.Decks["deck"])
+	}
+
+	// 덱이 비면 다시 채운 뒤 뽑습니다
+	drawOne(t, d, "deck")
+	if len(state.Decks["deck"]) != 2 {
+		t.Errorf("expected refilled deck with 2 entries left, got %v", state.Decks["deck"])
+	}
+}
+
+func TestDrawDeckStaleEntries(t *testing.T) {
+	tableRepo := loadTestTables(t, testRewardGroupCsv, testRewardGroupEntryCsv)
+	state := &entity.UserReward{
+		Uid:   "user1",
+		Decks: map[string][]string{"deck": {"deck-removed"}},
+	}
+	d := newTestDrawer(t, tableRepo, state)
+
+	// 테이블에서 사라진 보상만 남은 덱은 새 덱으로 시작합니다
+	drawOne(t, d, "deck")
+	deck := state.Decks["deck"]
+	if len(deck) != 2 || slices.Contains(deck, "deck-removed") {
+		t.Errorf("expected refilled deck with 2 entries left, got %v", deck)
+	}
+}
+
+// 중첩 그룹 nest-0 -> nest-1 -> ... -> nest-{depth}을 만들고 마지막 그룹에서 아이템을 지급합니다
+func nestedRewardCsv(depth int) (string, string) {
+	var groups, entries strings.Builder
+	groups.WriteString("Index,PickType,MaxWeight,PityCount,desc\n")
+	entries.WriteString("Index,GroupIndex,ItemIndex,ItemCount,SubGroupIndex,Weight,Pity\n")
+	for i := range depth + 1 {
+		fmt.Fprintf(&groups, "nest-%d,weighted,0,0,\n", i)
+		if i < depth {
+			fmt.Fprintf(&entries, "nest-%d-1,nest-%d,,0,nest-%d,1,FALSE\n", i, i, i+1)
+		} else {
+			fmt.Fprintf(&entries, "nest-%d-1,nest-%d,cap-644,1,,1,FALSE\n", i, i)
+		}
+	}
+	return groups.String(), entries.String()
+}
+
+func TestDrawNestedDepth(t *testing.T) {
+	groupCsv, entryCsv := nestedRewardCsv(maxRewardGroupDepth + 1)
+	tableRepo := loadTestTables(t, groupCsv, entryCsv)
+	d := newTestDrawer(t, tableRepo, &entity.UserReward{Uid: "user1"})
+
+	// 최대 깊이까지는 중첩 그룹을 따라가 보상을 뽑습니다
+	if got := drawOne(t, d, "nest-1"); got != "cap-644" {
+		t.Errorf("expected cap-644, got %v", got)
+	}
+
+	// 최대 깊이를 넘으면 뽑지 않습니다
+	if _, err := d.draw("nest-0", 0); !errors.Is(err, ErrRewardGroupTooDeep) {
+		t.Errorf("expected too deep error, got %v", err)
+	}
+}
+
+func TestDrawGroupNotFound(t *testing.T) {
+	tableRepo := loadTestTables(t, testRewardGroupCsv, testRewardGroupEntryCsv)
+	d := newTestDrawer(t, tableRepo, &entity.UserReward{Uid: "user1"})
+
+	if _, err := d.draw("unknown", 0); !errors.Is(err, ErrRewardGroupNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
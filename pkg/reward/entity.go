@@ -0,0 +1,61 @@
+package reward
+
+// 보상 뽑기 정보
+type RewardDraw struct {
+	// 유저 고유 ID
+	Uid string
+
+	// 보상 그룹 인덱스
+	GroupIndex string
+
+	// 뽑기 횟수
+	Count int
+}
+
+// 보상 아이템
+type RewardItem struct {
+	// 아이템 테이블 인덱스
+	Index string
+
+	// 아이템 개수
+	Count int64
+}
+
+// 보상 뽑기 결과
+type RewardDrawResult struct {
+	// 유저 고유 ID
+	Uid string
+
+	// 뽑은 보상 아이템 (뽑은 순서)
+	Items []*RewardItem
+
+	// 에러 코드
+	ErrorCode string
+}
+
+// 보상 시뮬레이션 아이템별 결과
+type RewardSimulationItem struct {
+	// 아이템 테이블 인덱스
+	Index string
+
+	// 테이블 설정에 따른 1회 뽑기당 기대 개수
+	Expected float64
+
+	// 시뮬레이션에서 관측된 1회 뽑기당 개수
+	Observed float64
+}
+
+// 보상 시뮬레이션 결과
+type RewardSimulation struct {
+	// 보상 그룹 인덱스
+	GroupIndex string
+
+	// 뽑기 횟수
+	Draws int
+
+	// 천장으로 지급된 횟수
+	PityDraws int
+
+	// 아이템별 결과 (아이템 인덱스 순)
+	Items []*RewardSimulationItem
+}
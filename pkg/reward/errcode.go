@@ -0,0 +1,20 @@
+package reward
+
+import "MScannot206/shared"
+
+// reward
+const REWARD_UNKNOWN_ERROR = "REWARD_UNKNOWN_ERROR"
+const REWARD_GROUP_NOT_FOUND_ERROR = "REWARD_GROUP_NOT_FOUND_ERROR"
+const REWARD_DRAW_COUNT_INVALID_ERROR = "REWARD_DRAW_COUNT_INVALID_ERROR"
+const REWARD_STATE_CONFLICT_ERROR = "REWARD_STATE_CONFLICT_ERROR"
+const REWARD_DB_WRITE_ERROR = "REWARD_DB_WRITE_ERROR"
+
+func init() {
+
+	// reward
+	shared.RegisterError(REWARD_UNKNOWN_ERROR, "보상 처리 중 알 수 없는 오류가 발생하였습니다")
+	shared.RegisterError(REWARD_GROUP_NOT_FOUND_ERROR, "존재하지 않는 보상 그룹입니다")
+	shared.RegisterError(REWARD_DRAW_COUNT_INVALID_ERROR, "잘못된 보상 뽑기 횟수입니다")
+	shared.RegisterError(REWARD_STATE_CONFLICT_ERROR, "보상 뽑기 상태가 동시에 변경되어 처리하지 못했습니다")
+	shared.RegisterError(REWARD_DB_WRITE_ERROR, "보상 처리 중 데이터베이스 쓰기 오류가 발생하였습니다")
+}
@@ -0,0 +1,14 @@
+package reward
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+)
+
+var ErrRandomServiceHandlerIsNil = errors.New("random service handler is null")
+
+// 랜덤 서비스 핸들러는 보상 서비스에서 보상 뽑기에 사용할 랜덤을 얻기 위해 사용하는 핸들러입니다
+type RandomServiceHandler interface {
+	NewRewardRand(ctx context.Context, uid string) (*rand.Rand, error)
+}
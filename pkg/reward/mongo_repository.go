@@ -0,0 +1,91 @@
+package reward
+
+import (
+	"MScannot206/shared"
+	"MScannot206/shared/entity"
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var ErrRewardMongoRepositoryIsNil = errors.New("reward mongo repository is null")
+
+func NewRewardMongoRepository(
+	ctx context.Context,
+	client *mongo.Client,
+	dbName string,
+) (*RewardMongoRepository, error) {
+	if client == nil {
+		return nil, errors.New("mongo client is null")
+	}
+
+	if dbName == "" {
+		return nil, errors.New("database name is empty")
+	}
+
+	return &RewardMongoRepository{
+		client:     client,
+		userReward: client.Database(dbName).Collection(shared.UserReward),
+	}, nil
+}
+
+type RewardMongoRepository struct {
+	client *mongo.Client
+
+	userReward *mongo.Collection
+}
+
+// 유저 보상 뽑기 상태를 조회합니다 (없으면 버전 0의 빈 상태를 반환합니다)
+func (r *RewardMongoRepository) FindUserReward(ctx context.Context, uid string) (*entity.UserReward, error) {
+	var userReward entity.UserReward
+	err := r.userReward.FindOne(ctx, bson.M{"_id": uid}).Decode(&userReward)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return &entity.UserReward{Uid: uid}, nil
+		}
+		return nil, err
+	}
+
+	return &userReward, nil
+}
+
+// 조회한 이후 다른 요청이 변경하지 않은 경우에만 유저 보상 뽑기 상태를 저장합니다
+// 다른 요청이 먼저 변경한 경우 false를 반환합니다
+func (r *RewardMongoRepository) SaveUserReward(ctx context.Context, userReward *entity.UserReward) (bool, error) {
+	if userReward.Version == 0 {
+		doc := *userReward
+		doc.Version = 1
+
+		if _, err := r.userReward.InsertOne(ctx, doc); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	}
+
+	filter := bson.M{
+		"_id":     userReward.Uid,
+		"version": userReward.Version,
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"pity":  userReward.Pity,
+			"decks": userReward.Decks,
+		},
+		"$inc": bson.M{
+			"version": 1,
+		},
+	}
+
+	result, err := r.userReward.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount > 0, nil
+}
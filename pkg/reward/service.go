@@ -0,0 +1,154 @@
+package reward
+
+import (
+	"MScannot206/shared/table"
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"github.com/rs/zerolog/log"
+)
+
+// 한 번에 뽑을 수 있는 최대 횟수
+const MaxRewardDrawCount = 100
+
+// 유저 상태 저장 충돌 시 다시 시도하는 횟수
+const rewardSaveRetryCount = 3
+
+func NewRewardService() (*RewardService, error) {
+	return &RewardService{}, nil
+}
+
+// 보상 서비스는 보상 그룹 테이블에 따라 보상을 뽑는 서비스입니다
+// 유저별 천장 카운터와 덱 상태를 저장하며, 뽑은 보상의 지급은 호출한 서비스에서 처리합니다
+type RewardService struct {
+	// 테이블 레포지토리 (테이블 리로드 시 교체됩니다)
+	tableRepo atomic.Pointer[table.Repository]
+
+	// 보상 DB 레포지토리
+	rewardRepo *RewardMongoRepository
+
+	randomServiceHandler RandomServiceHandler
+}
+
+func (s *RewardService) Start(ctx context.Context) error {
+	return nil
+}
+
+func (s *RewardService) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (s *RewardService) SetRepositories(
+	tableRepo *table.Repository,
+	rewardRepo *RewardMongoRepository,
+) error {
+	var errs error
+
+	if tableRepo == nil {
+		errs = errors.Join(errs, table.ErrTableRepositoryIsNil)
+	} else if err := s.OnTableReload(tableRepo); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	s.rewardRepo = rewardRepo
+	if rewardRepo == nil {
+		errs = errors.Join(errs, ErrRewardMongoRepositoryIsNil)
+	}
+
+	return errs
+}
+
+func (s *RewardService) SetHandlers(randomServiceHandler RandomServiceHandler) error {
+	s.randomServiceHandler = randomServiceHandler
+	if randomServiceHandler == nil {
+		return ErrRandomServiceHandlerIsNil
+	}
+	return nil
+}
+
+// 새로 불러온 테이블 레포지토리로 교체합니다
+func (s *RewardService) OnTableReload(tableRepo *table.Repository) error {
+	s.tableRepo.Store(tableRepo)
+	return nil
+}
+
+// 보상 그룹에서 보상을 뽑습니다. 반환되는 결과는 뽑기 정보와 같은 순서입니다
+func (s *RewardService) Draw(ctx context.Context, draws []*RewardDraw) ([]*RewardDrawResult, error) {
+	results := make([]*RewardDrawResult, 0, len(draws))
+	for _, draw := range draws {
+		result, err := s.drawUser(ctx, draw)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// 유저 상태를 불러와 뽑은 뒤 저장합니다. 다른 요청과 충돌하면 상태를 다시 불러와 처음부터 뽑습니다
+func (s *RewardService) drawUser(ctx context.Context, draw *RewardDraw) (*RewardDrawResult, error) {
+	result := &RewardDrawResult{Uid: draw.Uid}
+
+	if draw.Count <= 0 || draw.Count > MaxRewardDrawCount {
+		result.ErrorCode = REWARD_DRAW_COUNT_INVALID_ERROR
+		return result, nil
+	}
+
+	tableRepo := s.tableRepo.Load()
+	groups, err := table.Lookup[*table.RewardGroupTable](tableRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := groups.Get(draw.GroupIndex); !ok {
+		result.ErrorCode = REWARD_GROUP_NOT_FOUND_ERROR
+		return result, nil
+	}
+
+	for range rewardSaveRetryCount {
+		userReward, err := s.rewardRepo.FindUserReward(ctx, draw.Uid)
+		if err != nil {
+			return nil, err
+		}
+
+		rng, err := s.randomServiceHandler.NewRewardRand(ctx, draw.Uid)
+		if err != nil {
+			return nil, err
+		}
+
+		drawer, err := newRewardDrawer(tableRepo, userReward, rng)
+		if err != nil {
+			return nil, err
+		}
+
+		var items []*RewardItem
+		for range draw.Count {
+			drawItems, err := drawer.draw(draw.GroupIndex, 0)
+			if err != nil {
+				log.Err(err).Msgf("보상 뽑기 실패: %v - %v", draw.Uid, draw.GroupIndex)
+				result.ErrorCode = REWARD_UNKNOWN_ERROR
+				if errors.Is(err, ErrRewardGroupNotFound) {
+					result.ErrorCode = REWARD_GROUP_NOT_FOUND_ERROR
+				}
+				return result, nil
+			}
+			items = append(items, drawItems...)
+		}
+
+		saved, err := s.rewardRepo.SaveUserReward(ctx, userReward)
+		if err != nil {
+			log.Err(err).Msgf("보상 뽑기 상태 저장 실패: %v - %v", draw.Uid, draw.GroupIndex)
+			result.ErrorCode = REWARD_DB_WRITE_ERROR
+			return result, nil
+		}
+
+		if saved {
+			result.Items = items
+			return result, nil
+		}
+	}
+
+	result.ErrorCode = REWARD_STATE_CONFLICT_ERROR
+	return result, nil
+}
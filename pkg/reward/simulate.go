@@ -0,0 +1,133 @@
+package reward
+
+import (
+	"MScannot206/shared/entity"
+	"MScannot206/shared/table"
+	"MScannot206/shared/types"
+	"cmp"
+	"maps"
+	"math/rand/v2"
+	"slices"
+)
+
+// 보상 그룹을 draws번 뽑아 테이블 설정에 따른 기대 개수와 관측된 개수를 비교합니다
+// 한 명의 유저가 연속으로 뽑는 것으로 가정하므로 천장과 덱이 관측 결과에 반영됩니다
+func Simulate(tableRepo *table.Repository, groupIndex string, draws int, rng *rand.Rand) (*RewardSimulation, error) {
+	if draws <= 0 {
+		return nil, ErrRewardDrawCountInvalid
+	}
+
+	expected, err := expectedItems(tableRepo, groupIndex, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	drawer, err := newRewardDrawer(tableRepo, &entity.UserReward{}, rng)
+	if err != nil {
+		return nil, err
+	}
+
+	observed := make(map[string]int64, len(expected))
+	for range draws {
+		items, err := drawer.draw(groupIndex, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			observed[item.Index] += item.Count
+		}
+	}
+
+	indexes := slices.Collect(maps.Keys(expected))
+	for index := range observed {
+		if _, ok := expected[index]; !ok {
+			indexes = append(indexes, index)
+		}
+	}
+	slices.SortFunc(indexes, cmp.Compare)
+
+	simulation := &RewardSimulation{
+		GroupIndex: groupIndex,
+		Draws:      draws,
+		PityDraws:  drawer.pityDraws,
+		Items:      make([]*RewardSimulationItem, 0, len(indexes)),
+	}
+	for _, index := range indexes {
+		simulation.Items = append(simulation.Items, &RewardSimulationItem{
+			Index:    index,
+			Expected: expected[index],
+			Observed: float64(observed[index]) / float64(draws),
+		})
+	}
+
+	return simulation, nil
+}
+
+// 테이블 설정에 따른 1회 뽑기당 아이템별 기대 개수를 구합니다 (천장은 반영하지 않습니다)
+func expectedItems(tableRepo *table.Repository, groupIndex string, depth int) (map[string]float64, error) {
+	if depth > maxRewardGroupDepth {
+		return nil, ErrRewardGroupTooDeep
+	}
+
+	groups, err := table.Lookup[*table.RewardGroupTable](tableRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	group, ok := groups.Get(groupIndex)
+	if !ok {
+		return nil, ErrRewardGroupNotFound
+	}
+
+	entryTable, err := table.Lookup[*table.RewardGroupEntryTable](tableRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := entryTable.GetByGroupIndex(groupIndex)
+
+	var totalWeight float64
+	var deckSize int
+	for _, entry := range entries {
+		if entry.Weight > 0 {
+			totalWeight += entry.Weight
+			deckSize++
+		}
+	}
+
+	ret := make(map[string]float64)
+	for _, entry := range entries {
+		if entry.Weight <= 0 {
+			continue
+		}
+
+		var prob float64
+		switch group.PickType {
+		case types.RewardPickType_Weighted:
+			prob = entry.Weight / totalWeight
+		case types.RewardPickType_Independent:
+			if group.MaxWeight > 0 {
+				prob = min(entry.Weight/group.MaxWeight, 1)
+			}
+		case types.RewardPickType_Deck:
+			// 덱은 모든 보상이 한 번씩 나오므로 가중치와 관계없이 같은 비율로 나옵니다
+			prob = 1 / float64(deckSize)
+		}
+
+		if entry.ItemIndex != "" && entry.ItemCount > 0 {
+			ret[entry.ItemIndex] += prob * float64(entry.ItemCount)
+		}
+
+		if entry.SubGroupIndex != "" {
+			subItems, err := expectedItems(tableRepo, entry.SubGroupIndex, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			for index, count := range subItems {
+				ret[index] += prob * count
+			}
+		}
+	}
+
+	return ret, nil
+}
@@ -36,9 +36,9 @@ func (c *LoginCommand) Execute(args []string) error {
 		return framework.ErrInvalidCommandArgument
 	}
 
-	uid := args[0]
+	deviceId := args[0]
 
-	if err := c.loginLogic.RequestLogin(uid); err != nil {
+	if err := c.loginLogic.RequestLogin(deviceId); err != nil {
 		return err
 	}
 
@@ -46,5 +46,5 @@ func (c *LoginCommand) Execute(args []string) error {
 }
 
 func (c *LoginCommand) Description() string {
-	return framework.MakeCommandDescription(c.Commands(), "<uid>", "로그인을 요청 합니다.")
+	return framework.MakeCommandDescription(c.Commands(), "<device_id>", "기기 ID로 로그인을 요청 합니다.")
 }
@@ -9,6 +9,6 @@ import (
 var ErrUserLogicHandlerIsNil = errors.New("user logic handler is nil")
 
 type UserLogicHandler interface {
-	ConnectUser(userEntity *entity.User, token string) (*user.User, error)
+	ConnectUser(userEntity *entity.User, token string, refreshToken string) (*user.User, error)
 	DisconnectUser(uid string) error
 }
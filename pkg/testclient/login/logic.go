@@ -2,6 +2,7 @@ package login
 
 import (
 	api_login "MScannot206/pkg/api/login"
+	"MScannot206/pkg/auth/identity"
 	"MScannot206/pkg/login"
 	"MScannot206/pkg/testclient/framework"
 	"MScannot206/pkg/testclient/user/command"
@@ -56,16 +57,21 @@ func (l *LoginLogic) SetHandlers(
 	return errs
 }
 
-func (l *LoginLogic) RequestLogin(uid string) error {
-	if uid == "" {
-		return fmt.Errorf("uid is empty")
+func (l *LoginLogic) RequestLogin(deviceId string) error {
+	if deviceId == "" {
+		return fmt.Errorf("device id is empty")
 	}
 
 	req := &api_login.LoginRequest{
-		Uids: []string{uid},
+		Credentials: []*api_login.LoginCredential{
+			{
+				Provider: identity.ProviderDevice,
+				DeviceId: deviceId,
+			},
+		},
 	}
 
-	log.Info().Msgf("로그인 요청: %s", uid)
+	log.Info().Msgf("로그인 요청: %s", deviceId)
 
 	res, err := framework.WebRequest[api_login.LoginRequest, api_login.LoginResponse](l.client).
 		Endpoint("api/v1/login").
@@ -80,20 +86,22 @@ func (l *LoginLogic) RequestLogin(uid string) error {
 
 	var userEntity *entity.User
 	var token string = ""
+	var refreshToken string = ""
 
 	if successCount == 0 && failCount == 0 {
 		return shared.ToError(login.LOGIN_UNABLE)
 	} else if failCount > 0 {
-		for _, failUid := range res.Failures {
-			if failUid.Uid == uid {
-				return shared.ToError(failUid.ErrorCode)
+		for _, failure := range res.Failures {
+			if failure.Index == 0 {
+				return shared.ToError(failure.ErrorCode)
 			}
 		}
 	} else if successCount > 0 {
-		for _, successUid := range res.Successes {
-			if successUid.UserEntity.Uid == uid {
-				userEntity = successUid.UserEntity
-				token = successUid.Token
+		for _, success := range res.Successes {
+			if success.Index == 0 {
+				userEntity = success.UserEntity
+				token = success.Token
+				refreshToken = success.RefreshToken
 				break
 			}
 		}
@@ -103,14 +111,14 @@ func (l *LoginLogic) RequestLogin(uid string) error {
 		return shared.ToError(login.LOGIN_UNKNOWN_ERROR)
 	}
 
-	u, err := l.userLogicHandler.ConnectUser(userEntity, token)
+	u, err := l.userLogicHandler.ConnectUser(userEntity, token, refreshToken)
 	if err != nil {
 		return err
 	}
 
 	command.RegisterCommands(l.client, u)
 
-	log.Info().Msgf("로그인 성공: %s, 토큰: %s", uid, token)
+	log.Info().Msgf("로그인 성공: %s, 토큰: %s", userEntity.Uid, token)
 
 	return nil
 }
@@ -2,6 +2,7 @@ package list
 
 import (
 	"MScannot206/pkg/testclient/framework"
+	"MScannot206/pkg/testclient/user"
 	"MScannot206/pkg/testclient/user/handler"
 	"sort"
 
@@ -17,15 +18,24 @@ func NewCharacterListCommand(client framework.Client, userHandler handler.UserHa
 		return nil, handler.ErrUserHandlerIsNil
 	}
 
+	userLogic, err := framework.GetLogic[*user.UserLogic](client)
+	if err != nil {
+		return nil, err
+	}
+
 	return &CharacterListCommand{
 		client:      client,
 		userHandler: userHandler,
+
+		userLogic: userLogic,
 	}, nil
 }
 
 type CharacterListCommand struct {
 	client      framework.Client
 	userHandler handler.UserHandler
+
+	userLogic *user.UserLogic
 }
 
 func (c *CharacterListCommand) Commands() []string {
@@ -33,6 +43,10 @@ func (c *CharacterListCommand) Commands() []string {
 }
 
 func (c *CharacterListCommand) Execute(args []string) error {
+	if err := c.userLogic.RequestCharacterList(c.userHandler.GetUid()); err != nil {
+		return err
+	}
+
 	characterHandlers := c.userHandler.GetCharacterHandlers()
 
 	// Key 기준으로 오름차순 정렬
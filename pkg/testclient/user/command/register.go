@@ -6,6 +6,7 @@ import (
 	command_delete "MScannot206/pkg/testclient/user/characterselection/delete"
 	"MScannot206/pkg/testclient/user/characterselection/list"
 	"MScannot206/pkg/testclient/user/handler"
+	"MScannot206/pkg/testclient/user/refresh"
 	"errors"
 
 	"github.com/rs/zerolog/log"
@@ -40,6 +41,12 @@ func RegisterCommands(client framework.Client, userHandler handler.UserHandler)
 		log.Err(err)
 	}
 
+	refreshCmd, err := refresh.NewRefreshCommand(client, userHandler)
+	if err != nil {
+		errs = errors.Join(errs, err)
+		log.Err(err)
+	}
+
 	if errs != nil {
 		return errs
 	}
@@ -49,6 +56,7 @@ func RegisterCommands(client framework.Client, userHandler handler.UserHandler)
 		characterListCmd,
 		characterCreateCmd,
 		characterDeleteCmd,
+		refreshCmd,
 	} {
 		if err := userHandler.AddCommand(cmd); err != nil {
 			return err
@@ -12,14 +12,15 @@ import (
 
 var ErrUserIsNil = errors.New("user is nil")
 
-func NewUser(uid string, token string) (*User, error) {
+func NewUser(uid string, token string, refreshToken string) (*User, error) {
 	if uid == "" {
 		return nil, ErrUserIsNil
 	}
 
 	u := &User{
-		Uid:   uid,
-		Token: token,
+		Uid:          uid,
+		Token:        token,
+		RefreshToken: refreshToken,
 
 		Characters: []*character.Character{},
 	}
@@ -32,8 +33,9 @@ func NewUser(uid string, token string) (*User, error) {
 type User struct {
 	framework.InputMachine
 
-	Uid   string
-	Token string
+	Uid          string
+	Token        string
+	RefreshToken string
 
 	Characters []*character.Character
 }
@@ -1,7 +1,9 @@
 package user
 
 import (
+	login_api "MScannot206/pkg/api/login"
 	user_api "MScannot206/pkg/api/user"
+	"MScannot206/pkg/auth/session"
 	"MScannot206/pkg/testclient/framework"
 	"MScannot206/pkg/testclient/user/character"
 	"MScannot206/pkg/user"
@@ -43,14 +45,14 @@ func (l *UserLogic) Stop() error {
 	return nil
 }
 
-func (l *UserLogic) ConnectUser(userEntity *entity.User, token string) (*User, error) {
+func (l *UserLogic) ConnectUser(userEntity *entity.User, token string, refreshToken string) (*User, error) {
 	var errs error
 
 	if userEntity == nil {
 		return nil, entity.ErrUserIsNil
 	}
 
-	u, err := NewUser(userEntity.Uid, token)
+	u, err := NewUser(userEntity.Uid, token, refreshToken)
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +87,52 @@ func (l *UserLogic) GetUser(uid string) (*User, bool) {
 	return user, ok
 }
 
+func (l *UserLogic) RequestRefreshToken(uid string) error {
+	u, ok := l.users[uid]
+	if !ok {
+		return ErrUserNotFound
+	}
+
+	req := &login_api.RefreshRequest{
+		Requests: []*login_api.RefreshInfo{
+			{
+				Uid:          u.Uid,
+				RefreshToken: u.RefreshToken,
+			},
+		},
+	}
+
+	res, err := framework.WebRequest[login_api.RefreshRequest, login_api.RefreshResponse](l.client).
+		Endpoint("api/v1/auth/refresh").
+		Body(req).
+		Post()
+
+	if err != nil {
+		return err
+	}
+
+	var response *login_api.RefreshResult
+	for _, r := range res.Responses {
+		if r.Uid == uid {
+			response = r
+			break
+		}
+	}
+
+	if response == nil {
+		return shared.ToError(session.SESSION_REFRESH_TOKEN_INVALID_ERROR)
+	}
+
+	if response.ErrorCode != "" {
+		return shared.ToError(response.ErrorCode)
+	}
+
+	u.Token = response.Token
+	u.RefreshToken = response.RefreshToken
+
+	return nil
+}
+
 func (l *UserLogic) GetCharacterSlotCount(uid string) (int, error) {
 	u, ok := l.users[uid]
 	if !ok {
@@ -99,6 +147,65 @@ func (l *UserLogic) GetCharacterSlotCount(uid string) (int, error) {
 	return slotCount, nil
 }
 
+func (l *UserLogic) RequestCharacterList(uid string) error {
+	u, ok := l.users[uid]
+	if !ok {
+		return ErrUserNotFound
+	}
+
+	req := &user_api.CharacterListRequest{
+		Requests: []*user_api.UserCharacterListInfo{
+			{
+				Uid:   u.Uid,
+				Token: u.Token,
+			},
+		},
+	}
+
+	res, err := framework.WebRequest[user_api.CharacterListRequest, user_api.CharacterListResponse](l.client).
+		Endpoint("api/v1/user/character/list").
+		Body(req).
+		Post()
+
+	if err != nil {
+		return err
+	}
+
+	var response *user_api.UserCharacterListResult
+	for _, r := range res.Responses {
+		if r.Uid == uid {
+			response = r
+			break
+		}
+	}
+
+	if response == nil {
+		return shared.ToError(user.USER_CHARACTER_LOAD_ERROR)
+	}
+
+	if response.ErrorCode != "" {
+		return shared.ToError(response.ErrorCode)
+	}
+
+	var errs error
+	characters := make([]*character.Character, 0, len(response.Characters))
+	for _, entry := range response.Characters {
+		ch, err := character.NewCharacter(entry.Slot, entry.Name)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		characters = append(characters, ch)
+	}
+
+	if errs != nil {
+		return errs
+	}
+
+	u.Characters = characters
+	return nil
+}
+
 func (l *UserLogic) RequestCheckCharacterName(uid string, name string) error {
 	u, ok := l.users[uid]
 	if !ok {
@@ -0,0 +1,56 @@
+package refresh
+
+import (
+	"MScannot206/pkg/testclient/framework"
+	"MScannot206/pkg/testclient/user"
+	"MScannot206/pkg/testclient/user/handler"
+
+	"github.com/rs/zerolog/log"
+)
+
+func NewRefreshCommand(client framework.Client, userHandler handler.UserHandler) (*RefreshCommand, error) {
+	if client == nil {
+		return nil, framework.ErrClientIsNil
+	}
+
+	if userHandler == nil {
+		return nil, handler.ErrUserHandlerIsNil
+	}
+
+	userLogic, err := framework.GetLogic[*user.UserLogic](client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RefreshCommand{
+		client:      client,
+		userHandler: userHandler,
+
+		userLogic: userLogic,
+	}, nil
+}
+
+type RefreshCommand struct {
+	client      framework.Client
+	userHandler handler.UserHandler
+
+	userLogic *user.UserLogic
+}
+
+func (c *RefreshCommand) Commands() []string {
+	return []string{"refresh"}
+}
+
+func (c *RefreshCommand) Execute(args []string) error {
+	if err := c.userLogic.RequestRefreshToken(c.userHandler.GetUid()); err != nil {
+		return err
+	}
+
+	log.Info().Msgf("세션 갱신 성공: %s, 토큰: %s", c.userHandler.GetUid(), c.userHandler.GetToken())
+
+	return nil
+}
+
+func (c *RefreshCommand) Description() string {
+	return framework.MakeCommandDescription(c.Commands(), "", "세션 토큰 갱신을 요청 합니다.")
+}
@@ -3,6 +3,7 @@ package user
 import (
 	"MScannot206/shared/entity"
 	"MScannot206/shared/types"
+	"time"
 )
 
 // 유저 엔티티 정의
@@ -14,6 +15,27 @@ type UserEntity struct {
 	Token string
 }
 
+// 캐릭터 이름 검사 정보
+type UserCheckCharacterName struct {
+	// 유저 고유 ID
+	Uid string
+
+	// 검사할 캐릭터 이름
+	Name string
+
+	// 사용 가능한 이름을 유저에게 예약할지 여부
+	Reserve bool
+}
+
+// 캐릭터 이름 검사 결과
+type UserCheckCharacterNameResult struct {
+	// 예약 만료 일시 (예약한 경우에만 존재합니다)
+	ReservedUntil time.Time
+
+	// 에러 코드
+	ErrorCode string
+}
+
 // 캐릭터 생성 정보
 type UserCreateCharacter struct {
 	// 유저 고유 ID
@@ -27,6 +49,12 @@ type UserCreateCharacter struct {
 
 	// 생성할 캐릭터 성별 (1: 남성, 2: 여성)
 	Gender int
+
+	// 직접 선택한 외형 (머리/얼굴/피부/귀, 생략한 종류는 랜덤으로 결정됩니다)
+	Appearance map[types.CharacterEquipType]string
+
+	// 생성할 캐릭터 장비 정보
+	Equips []*entity.CharacterEquip
 }
 
 // 캐릭터 생성 결과
@@ -41,6 +69,36 @@ type UserCreateCharacterResult struct {
 	ErrorCode string
 }
 
+// 캐릭터 생성 미리보기 정보
+type UserPreviewCharacter struct {
+	// 유저 고유 ID
+	Uid string
+
+	// 생성할 캐릭터 슬롯 번호
+	Slot int
+
+	// 생성할 캐릭터 성별 (1: 남성, 2: 여성)
+	Gender int
+
+	// 직접 선택한 외형 (머리/얼굴/피부/귀, 생략한 종류는 랜덤으로 결정됩니다)
+	Appearance map[types.CharacterEquipType]string
+}
+
+// 캐릭터 생성 미리보기 결과
+type UserPreviewCharacterResult struct {
+	// 미리보기로 결정된 장비 정보
+	Equips map[types.CharacterEquipType]string
+
+	// 남은 다시 굴리기 횟수
+	RerollsLeft int
+
+	// 초안 만료 일시
+	ExpireAt time.Time
+
+	// 에러 코드
+	ErrorCode string
+}
+
 // 캐릭터 삭제 정보
 type UserDeleteCharacter struct {
 	// 유저 고유 ID
@@ -52,3 +110,114 @@ type UserDeleteCharacter struct {
 	// 삭제할 캐릭터 이름
 	Name string
 }
+
+// 캐릭터 삭제 결과
+type UserDeleteCharacterResult struct {
+	// 완전 삭제 일시 (이전까지 복구할 수 있습니다)
+	PurgeAt time.Time
+
+	// 에러 코드
+	ErrorCode string
+}
+
+// 캐릭터 복구 정보
+type UserRestoreCharacter struct {
+	// 유저 고유 ID
+	Uid string
+
+	// 복구할 캐릭터 슬롯 번호
+	Slot int
+}
+
+// 캐릭터 복구 결과
+type UserRestoreCharacterResult struct {
+	// 복구된 캐릭터 정보
+	Character *entity.Character
+
+	// 에러 코드
+	ErrorCode string
+}
+
+// 캐릭터 이름 변경 정보
+type UserRenameCharacter struct {
+	// 유저 고유 ID
+	Uid string
+
+	// 이름을 변경할 캐릭터 슬롯 번호
+	Slot int
+
+	// 변경할 캐릭터 이름
+	Name string
+
+	// 변경 전 캐릭터 이름 (서비스에서 채웁니다)
+	OldName string
+}
+
+// 캐릭터 이름 변경 결과
+type UserRenameCharacterResult struct {
+	// 이름이 변경된 캐릭터 정보
+	Character *entity.Character
+
+	// 다음으로 이름을 변경할 수 있는 일시
+	NextRenameAt time.Time
+
+	// 에러 코드
+	ErrorCode string
+}
+
+// 캐릭터 슬롯 확장 결과
+type UserExpandCharacterSlotResult struct {
+	// 확장 후 캐릭터 슬롯 수 (실패 시 현재 캐릭터 슬롯 수)
+	SlotLimit int
+
+	// 에러 코드
+	ErrorCode string
+}
+
+// 캐릭터 장비 장착 정보
+type UserEquipCharacter struct {
+	// 유저 고유 ID
+	Uid string
+
+	// 장착할 캐릭터 슬롯 번호
+	Slot int
+
+	// 장착할 장비 아이템 인덱스
+	Index string
+}
+
+// 캐릭터 장비 해제 정보
+type UserUnequipCharacter struct {
+	// 유저 고유 ID
+	Uid string
+
+	// 해제할 캐릭터 슬롯 번호
+	Slot int
+
+	// 해제할 장비 종류
+	Type types.CharacterEquipType
+}
+
+// 캐릭터 장비 변경 결과
+type UserCharacterEquipResult struct {
+	// 변경된 캐릭터 장비 목록
+	Equips []*entity.CharacterEquip
+
+	// 에러 코드
+	ErrorCode string
+}
+
+// 캐릭터 장비 갱신 정보
+type UserUpdateCharacterEquips struct {
+	// 유저 고유 ID
+	Uid string
+
+	// 캐릭터 슬롯 번호
+	Slot int
+
+	// 조회한 시점의 장비 목록 (그 사이 다른 요청으로 장비가 바뀌었으면 갱신하지 않습니다)
+	PrevEquips []*entity.CharacterEquip
+
+	// 갱신할 장비 목록
+	Equips []*entity.CharacterEquip
+}
@@ -9,6 +9,7 @@ import (
 // character
 const USER_CHARACTER_LOAD_ERROR = "USER_CHARACTER_LOAD_ERROR"
 const USER_CHARACTER_LOAD_NEED_LOGIN_ERROR = "USER_CHARACTER_LOAD_NEED_LOGIN_ERROR"
+const USER_GET_CHARACTER_SLOT_NOT_FOUND_ERROR = "USER_GET_CHARACTER_SLOT_NOT_FOUND_ERROR"
 
 // character create
 const USER_CHECK_CHARACTER_NAME_UNKNOWN_ERROR = "USER_CHECK_CHARACTER_NAME_UNKNOWN_ERROR"
@@ -21,8 +22,17 @@ const USER_CREATE_CHARACTER_USER_NOT_FOUND = "USER_CREATE_CHARACTER_USER_NOT_FOU
 const USER_CREATE_CHARACTER_NAME_MIN_LENGTH_ERROR = "USER_CREATE_CHARACTER_NAME_MIN_LENGTH_ERROR"
 const USER_CREATE_CHARACTER_NAME_MAX_LENGTH_ERROR = "USER_CREATE_CHARACTER_NAME_MAX_LENGTH_ERROR"
 const USER_CREATE_CHARACTER_NAME_SPECIAL_CHAR_ERROR = "USER_CREATE_CHARACTER_NAME_SPECIAL_CHAR_ERROR"
+const USER_CREATE_CHARACTER_NAME_BANNED_WORD_ERROR = "USER_CREATE_CHARACTER_NAME_BANNED_WORD_ERROR"
+const USER_CREATE_CHARACTER_NAME_RESERVED_ERROR = "USER_CREATE_CHARACTER_NAME_RESERVED_ERROR"
 const USER_CREATE_CHARACTER_DB_WRITE_ERROR = "USER_CREATE_CHARACTER_DB_WRITE_ERROR"
 const USER_CREATE_CHARACTER_GENDER_INVALID_ERROR = "USER_CREATE_CHARACTER_GENDER_INVALID_ERROR"
+const USER_CREATE_CHARACTER_APPEARANCE_TYPE_INVALID_ERROR = "USER_CREATE_CHARACTER_APPEARANCE_TYPE_INVALID_ERROR"
+const USER_CREATE_CHARACTER_APPEARANCE_INDEX_INVALID_ERROR = "USER_CREATE_CHARACTER_APPEARANCE_INDEX_INVALID_ERROR"
+
+// character create preview
+const USER_PREVIEW_CHARACTER_REROLL_LIMIT_ERROR = "USER_PREVIEW_CHARACTER_REROLL_LIMIT_ERROR"
+const USER_PREVIEW_CHARACTER_ALREADY_REQUEST = "USER_PREVIEW_CHARACTER_ALREADY_REQUEST"
+const USER_CREATE_CHARACTER_DRAFT_GENDER_MISMATCH_ERROR = "USER_CREATE_CHARACTER_DRAFT_GENDER_MISMATCH_ERROR"
 
 // character delete
 const USER_DELETE_CHARACTER_UNKNOWN_ERROR = "USER_DELETE_CHARACTER_UNKNOWN_ERROR"
@@ -31,12 +41,46 @@ const USER_DELETE_CHARACTER_ALREADY_REQUEST = "USER_DELETE_CHARACTER_ALREADY_REQ
 const USER_DELETE_CHARACTER_USER_NOT_FOUND = "USER_DELETE_CHARACTER_USER_NOT_FOUND"
 const USER_DELETE_CHARACTER_SLOT_NOT_FOUND_ERROR = "USER_DELETE_CHARACTER_SLOT_NOT_FOUND_ERROR"
 const USER_DELETE_CHARACTER_DB_WRITE_ERROR = "USER_DELETE_CHARACTER_DB_WRITE_ERROR"
+const USER_CHARACTER_SLOT_DELETE_PENDING_ERROR = "USER_CHARACTER_SLOT_DELETE_PENDING_ERROR"
+
+// character restore
+const USER_RESTORE_CHARACTER_SLOT_INVALID_ERROR = "USER_RESTORE_CHARACTER_SLOT_INVALID_ERROR"
+const USER_RESTORE_CHARACTER_NOT_FOUND_ERROR = "USER_RESTORE_CHARACTER_NOT_FOUND_ERROR"
+const USER_RESTORE_CHARACTER_DB_WRITE_ERROR = "USER_RESTORE_CHARACTER_DB_WRITE_ERROR"
+
+// character rename
+const USER_RENAME_CHARACTER_SLOT_NOT_FOUND_ERROR = "USER_RENAME_CHARACTER_SLOT_NOT_FOUND_ERROR"
+const USER_RENAME_CHARACTER_SAME_NAME_ERROR = "USER_RENAME_CHARACTER_SAME_NAME_ERROR"
+const USER_RENAME_CHARACTER_COOLDOWN_ERROR = "USER_RENAME_CHARACTER_COOLDOWN_ERROR"
+const USER_RENAME_CHARACTER_DB_WRITE_ERROR = "USER_RENAME_CHARACTER_DB_WRITE_ERROR"
+
+// character slot expand
+const USER_EXPAND_CHARACTER_SLOT_DISABLED_ERROR = "USER_EXPAND_CHARACTER_SLOT_DISABLED_ERROR"
+const USER_EXPAND_CHARACTER_SLOT_MAX_ERROR = "USER_EXPAND_CHARACTER_SLOT_MAX_ERROR"
+const USER_EXPAND_CHARACTER_SLOT_USER_NOT_FOUND = "USER_EXPAND_CHARACTER_SLOT_USER_NOT_FOUND"
+const USER_EXPAND_CHARACTER_SLOT_COST_NOT_ENOUGH_ERROR = "USER_EXPAND_CHARACTER_SLOT_COST_NOT_ENOUGH_ERROR"
+const USER_EXPAND_CHARACTER_SLOT_ALREADY_REQUEST = "USER_EXPAND_CHARACTER_SLOT_ALREADY_REQUEST"
+const USER_EXPAND_CHARACTER_SLOT_DB_WRITE_ERROR = "USER_EXPAND_CHARACTER_SLOT_DB_WRITE_ERROR"
+
+// character equip
+const USER_EQUIP_CHARACTER_SLOT_NOT_FOUND_ERROR = "USER_EQUIP_CHARACTER_SLOT_NOT_FOUND_ERROR"
+const USER_EQUIP_ITEM_NOT_FOUND_ERROR = "USER_EQUIP_ITEM_NOT_FOUND_ERROR"
+const USER_EQUIP_ITEM_TYPE_INVALID_ERROR = "USER_EQUIP_ITEM_TYPE_INVALID_ERROR"
+const USER_EQUIP_ITEM_GENDER_MISMATCH_ERROR = "USER_EQUIP_ITEM_GENDER_MISMATCH_ERROR"
+const USER_EQUIP_ITEM_ALREADY_EQUIPPED_ERROR = "USER_EQUIP_ITEM_ALREADY_EQUIPPED_ERROR"
+const USER_EQUIP_ITEM_NOT_OWNED_ERROR = "USER_EQUIP_ITEM_NOT_OWNED_ERROR"
+const USER_UNEQUIP_TYPE_INVALID_ERROR = "USER_UNEQUIP_TYPE_INVALID_ERROR"
+const USER_UNEQUIP_TYPE_REQUIRED_ERROR = "USER_UNEQUIP_TYPE_REQUIRED_ERROR"
+const USER_UNEQUIP_NOT_EQUIPPED_ERROR = "USER_UNEQUIP_NOT_EQUIPPED_ERROR"
+const USER_EQUIP_CHARACTER_CHANGED_ERROR = "USER_EQUIP_CHARACTER_CHANGED_ERROR"
+const USER_EQUIP_CHARACTER_DB_WRITE_ERROR = "USER_EQUIP_CHARACTER_DB_WRITE_ERROR"
 
 func init() {
 
 	// character
 	shared.RegisterError(USER_CHARACTER_LOAD_ERROR, "캐릭터를 불러오지 못합니다")
 	shared.RegisterError(USER_CHARACTER_LOAD_NEED_LOGIN_ERROR, "캐릭터를 불러오기 위해 로그인이 필요합니다")
+	shared.RegisterError(USER_GET_CHARACTER_SLOT_NOT_FOUND_ERROR, "해당 슬롯에 캐릭터가 존재하지 않습니다")
 
 	// character create
 	shared.RegisterError(USER_CHECK_CHARACTER_NAME_UNKNOWN_ERROR, "캐릭터 이름 확인 중 알 수 없는 오류가 발생하였습니다")
@@ -46,11 +90,20 @@ func init() {
 	shared.RegisterError(USER_CHARACTER_SLOT_ALREADY_EXISTS_ERROR, "이미 해당 슬롯에 캐릭터가 존재합니다")
 	shared.RegisterError(USER_CREATE_CHARACTER_ALREADY_REQUEST, "이미 캐릭터 생성 요청이 진행 중입니다")
 	shared.RegisterError(USER_CREATE_CHARACTER_USER_NOT_FOUND, "사용자를 찾을 수 없습니다")
-	shared.RegisterError(USER_CREATE_CHARACTER_NAME_MIN_LENGTH_ERROR, fmt.Sprintf("캐릭터 이름은 최소 %d자 이상이어야 합니다", def.MinCharacterNameLength))
-	shared.RegisterError(USER_CREATE_CHARACTER_NAME_MAX_LENGTH_ERROR, fmt.Sprintf("캐릭터 이름은 최대 %d자 이하여야 합니다", def.MaxCharacterNameLength))
+	shared.RegisterError(USER_CREATE_CHARACTER_NAME_MIN_LENGTH_ERROR, fmt.Sprintf("캐릭터 이름은 최소 %d칸 이상이어야 합니다 (한글, 한자, 가나는 2칸)", def.MinCharacterNameWidth))
+	shared.RegisterError(USER_CREATE_CHARACTER_NAME_MAX_LENGTH_ERROR, fmt.Sprintf("캐릭터 이름은 최대 %d칸 이하여야 합니다 (한글, 한자, 가나는 2칸)", def.MaxCharacterNameWidth))
 	shared.RegisterError(USER_CREATE_CHARACTER_NAME_SPECIAL_CHAR_ERROR, "캐릭터 이름에 사용할 수 없는 문자가 포함되어 있습니다")
+	shared.RegisterError(USER_CREATE_CHARACTER_NAME_BANNED_WORD_ERROR, "캐릭터 이름에 사용할 수 없는 단어가 포함되어 있습니다")
+	shared.RegisterError(USER_CREATE_CHARACTER_NAME_RESERVED_ERROR, "사용할 수 없는 캐릭터 이름입니다")
 	shared.RegisterError(USER_CREATE_CHARACTER_DB_WRITE_ERROR, "캐릭터 생성 중 데이터베이스 쓰기 오류가 발생하였습니다")
 	shared.RegisterError(USER_CREATE_CHARACTER_GENDER_INVALID_ERROR, "잘못된 성별입니다")
+	shared.RegisterError(USER_CREATE_CHARACTER_APPEARANCE_TYPE_INVALID_ERROR, "캐릭터 생성 시 선택할 수 없는 외형 종류입니다")
+	shared.RegisterError(USER_CREATE_CHARACTER_APPEARANCE_INDEX_INVALID_ERROR, "캐릭터 생성 시 선택할 수 없는 외형입니다")
+
+	// character create preview
+	shared.RegisterError(USER_PREVIEW_CHARACTER_REROLL_LIMIT_ERROR, fmt.Sprintf("캐릭터 미리보기는 최대 %d번까지 다시 굴릴 수 있습니다", MaxCharacterDraftRerolls))
+	shared.RegisterError(USER_PREVIEW_CHARACTER_ALREADY_REQUEST, "이미 캐릭터 미리보기 요청이 진행 중입니다")
+	shared.RegisterError(USER_CREATE_CHARACTER_DRAFT_GENDER_MISMATCH_ERROR, "미리보기한 캐릭터와 성별이 다릅니다")
 
 	// character delete
 	shared.RegisterError(USER_DELETE_CHARACTER_UNKNOWN_ERROR, "캐릭터 삭제 중 알 수 없는 오류가 발생하였습니다")
@@ -59,4 +112,37 @@ func init() {
 	shared.RegisterError(USER_DELETE_CHARACTER_USER_NOT_FOUND, "사용자를 찾을 수 없습니다")
 	shared.RegisterError(USER_DELETE_CHARACTER_SLOT_NOT_FOUND_ERROR, "해당 슬롯에 캐릭터가 존재하지 않습니다")
 	shared.RegisterError(USER_DELETE_CHARACTER_DB_WRITE_ERROR, "캐릭터 삭제 중 데이터베이스 쓰기 오류가 발생하였습니다")
+	shared.RegisterError(USER_CHARACTER_SLOT_DELETE_PENDING_ERROR, "삭제 대기 중인 캐릭터가 해당 슬롯을 사용하고 있습니다")
+
+	// character restore
+	shared.RegisterError(USER_RESTORE_CHARACTER_SLOT_INVALID_ERROR, "잘못된 캐릭터 슬롯입니다")
+	shared.RegisterError(USER_RESTORE_CHARACTER_NOT_FOUND_ERROR, "복구할 수 있는 삭제된 캐릭터가 없습니다")
+	shared.RegisterError(USER_RESTORE_CHARACTER_DB_WRITE_ERROR, "캐릭터 복구 중 데이터베이스 쓰기 오류가 발생하였습니다")
+
+	// character rename
+	shared.RegisterError(USER_RENAME_CHARACTER_SLOT_NOT_FOUND_ERROR, "해당 슬롯에 캐릭터가 존재하지 않습니다")
+	shared.RegisterError(USER_RENAME_CHARACTER_SAME_NAME_ERROR, "현재 캐릭터 이름과 같은 이름입니다")
+	shared.RegisterError(USER_RENAME_CHARACTER_COOLDOWN_ERROR, "아직 캐릭터 이름을 변경할 수 없습니다")
+	shared.RegisterError(USER_RENAME_CHARACTER_DB_WRITE_ERROR, "캐릭터 이름 변경 중 데이터베이스 쓰기 오류가 발생하였습니다")
+
+	// character slot expand
+	shared.RegisterError(USER_EXPAND_CHARACTER_SLOT_DISABLED_ERROR, "캐릭터 슬롯을 확장할 수 없습니다")
+	shared.RegisterError(USER_EXPAND_CHARACTER_SLOT_MAX_ERROR, fmt.Sprintf("캐릭터 슬롯은 최대 %d개까지 확장할 수 있습니다", def.MaxCharacterSlot))
+	shared.RegisterError(USER_EXPAND_CHARACTER_SLOT_USER_NOT_FOUND, "사용자를 찾을 수 없습니다")
+	shared.RegisterError(USER_EXPAND_CHARACTER_SLOT_COST_NOT_ENOUGH_ERROR, "캐릭터 슬롯 확장에 필요한 아이템이 부족합니다")
+	shared.RegisterError(USER_EXPAND_CHARACTER_SLOT_ALREADY_REQUEST, "이미 캐릭터 슬롯 확장 요청이 진행 중입니다")
+	shared.RegisterError(USER_EXPAND_CHARACTER_SLOT_DB_WRITE_ERROR, "캐릭터 슬롯 확장 중 데이터베이스 쓰기 오류가 발생하였습니다")
+
+	// character equip
+	shared.RegisterError(USER_EQUIP_CHARACTER_SLOT_NOT_FOUND_ERROR, "해당 슬롯에 캐릭터가 존재하지 않습니다")
+	shared.RegisterError(USER_EQUIP_ITEM_NOT_FOUND_ERROR, "장착할 수 있는 아이템이 아닙니다")
+	shared.RegisterError(USER_EQUIP_ITEM_TYPE_INVALID_ERROR, "장비 종류를 알 수 없는 아이템입니다")
+	shared.RegisterError(USER_EQUIP_ITEM_GENDER_MISMATCH_ERROR, "캐릭터 성별과 맞지 않는 아이템입니다")
+	shared.RegisterError(USER_EQUIP_ITEM_ALREADY_EQUIPPED_ERROR, "이미 장착 중인 아이템입니다")
+	shared.RegisterError(USER_EQUIP_ITEM_NOT_OWNED_ERROR, "캐릭터 인벤토리나 계정 공용 인벤토리에 없는 아이템입니다")
+	shared.RegisterError(USER_UNEQUIP_TYPE_INVALID_ERROR, "잘못된 장비 종류입니다")
+	shared.RegisterError(USER_UNEQUIP_TYPE_REQUIRED_ERROR, "해제할 수 없는 장비입니다")
+	shared.RegisterError(USER_UNEQUIP_NOT_EQUIPPED_ERROR, "장착 중인 장비가 없습니다")
+	shared.RegisterError(USER_EQUIP_CHARACTER_CHANGED_ERROR, "다른 요청으로 캐릭터 장비가 변경되었습니다. 다시 시도해 주세요")
+	shared.RegisterError(USER_EQUIP_CHARACTER_DB_WRITE_ERROR, "장비 변경 중 데이터베이스 쓰기 오류가 발생하였습니다")
 }
@@ -1,13 +1,27 @@
 package user
 
 import (
+	"MScannot206/shared/entity"
+	"context"
 	"errors"
 	"math/rand/v2"
 )
 
 var ErrRandomServiceHandlerIsNil = errors.New("random service handler is null")
 
-// 랜덤 서비스 핸들러는 유저 서비스에서 랜덤 시드 정보를 얻기 위해 사용하는 핸들러입니다
+// 랜덤 서비스 핸들러는 유저 서비스에서 캐릭터 생성 추첨에 사용할 랜덤을 얻기 위해 사용하는 핸들러입니다
 type RandomServiceHandler interface {
-	GetCharacterCreateSeed() *rand.Rand
+	NewCharacterCreateRand(ctx context.Context, uid string) (*rand.Rand, error)
+}
+
+var ErrInventoryServiceHandlerIsNil = errors.New("inventory service handler is null")
+
+// 인벤토리 서비스 핸들러는 유저 서비스에서 삭제된 캐릭터의 인벤토리를 정리하고 캐릭터 슬롯 확장 비용을 소모하며,
+// 장착할 장비의 소유 여부를 확인하기 위해 사용하는 핸들러입니다
+type InventoryServiceHandler interface {
+	DeleteCharacterInventories(ctx context.Context, uidSlots map[string]int) error
+	ConsumeAccountItem(ctx context.Context, uid string, index string, count int64) ([]*entity.InventoryItem, string, error)
+	RestoreAccountItems(ctx context.Context, consumed []*entity.InventoryItem) error
+	FindOwnedItemIndexes(ctx context.Context, uidSlots map[string]int) (map[string]map[string]bool, error)
+	AddCharacterEquipItems(ctx context.Context, characters map[string]*entity.Character) error
 }
@@ -2,24 +2,38 @@ package user
 
 import (
 	"MScannot206/shared/def"
-	"MScannot206/shared/util"
-	"unicode/utf8"
+	"MScannot206/shared/entity"
 )
 
-// 유저 캐릭터 슬롯 판별
-func IsInvalidCharacterSlot(slot int) bool {
-	return slot < 1 || slot > def.MaxCharacterSlot
+// 유저 캐릭터 슬롯 판별 (slotLimit은 유저의 캐릭터 슬롯 수)
+func IsInvalidCharacterSlot(slot int, slotLimit int) bool {
+	return slot < 1 || slot > min(slotLimit, def.MaxCharacterSlot)
 }
 
-// 유저 캐릭터 이름 유효성 검사
-func ValidateCharacterName(name string, locale def.Locale) string {
-	l := utf8.RuneCountInString(name)
-	if l < def.MinCharacterNameLength {
-		return USER_CREATE_CHARACTER_NAME_MIN_LENGTH_ERROR
-	} else if l > def.MaxCharacterNameLength {
-		return USER_CREATE_CHARACTER_NAME_MAX_LENGTH_ERROR
-	} else if util.HasSpecialChar(name, locale) {
-		return USER_CREATE_CHARACTER_NAME_SPECIAL_CHAR_ERROR
+// 슬롯 번호로 캐릭터를 찾습니다
+func findCharacterBySlot(characters []*entity.Character, slot int) *entity.Character {
+	for _, character := range characters {
+		if character.Slot == slot {
+			return character
+		}
 	}
-	return ""
+	return nil
+}
+
+func findCharacterDraftBySlot(drafts []*entity.CharacterDraft, slot int) *entity.CharacterDraft {
+	for _, draft := range drafts {
+		if draft.Slot == slot {
+			return draft
+		}
+	}
+	return nil
+}
+
+func findDeletedCharacterBySlot(deletedCharacters []*entity.DeletedCharacter, slot int) *entity.DeletedCharacter {
+	for _, deleted := range deletedCharacters {
+		if deleted.Character != nil && deleted.Character.Slot == slot {
+			return deleted
+		}
+	}
+	return nil
 }
@@ -0,0 +1,127 @@
+package user
+
+import (
+	"MScannot206/shared/entity"
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 캐릭터 이름 정규 키 변환 완료 기록의 ID와 버전
+const (
+	characterNameMigrationId      = "character_name_key"
+	characterNameMigrationVersion = 1
+)
+
+// 이전 버전에서 입력한 이름 그대로를 _id로 저장한 캐릭터 이름을 정규 키로 옮깁니다
+// 표시용 이름(name)이 없는 이름만 옮기므로 여러 번 실행하거나 중단 후 다시 실행해도 안전합니다
+// 정규 키가 같은 이름이 이미 있는 경우(예: "Hero"와 "hero"가 함께 사용 중) 옮기지 않고 로그를 남깁니다
+// 변환을 마치면 완료 기록을 남기고, 이후 서버 시작 시에는 캐릭터 이름을 검사하지 않습니다
+func (r *UserMongoRepository) migrateCharacterNames(ctx context.Context) error {
+	err := r.migration.FindOne(ctx, bson.D{
+		{Key: "_id", Value: characterNameMigrationId},
+		{Key: "version", Value: bson.D{
+			{Key: "$gte", Value: characterNameMigrationVersion},
+		}},
+	}).Err()
+	if err == nil {
+		return nil
+	} else if err != mongo.ErrNoDocuments {
+		return err
+	}
+
+	cursor, err := r.characterName.Find(ctx, bson.D{
+		{Key: "name", Value: bson.D{
+			{Key: "$exists", Value: false},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	migrated, conflicts := 0, 0
+	for cursor.Next(ctx) {
+		var charName entity.CharacterName
+		if err := cursor.Decode(&charName); err != nil {
+			return err
+		}
+
+		conflict, err := r.migrateCharacterName(ctx, &charName)
+		if err != nil {
+			return err
+		}
+
+		if conflict {
+			conflicts++
+			log.Warn().Msgf("정규 키가 같은 캐릭터 이름이 있어 옮기지 못했습니다: %v", charName.Key)
+		} else {
+			migrated++
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+
+	if migrated > 0 || conflicts > 0 {
+		log.Info().Msgf("캐릭터 이름 정규 키 변환: %d개 변환, %d개 충돌", migrated, conflicts)
+	}
+
+	_, err = r.migration.UpdateOne(ctx, bson.D{
+		{Key: "_id", Value: characterNameMigrationId},
+	}, bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "version", Value: characterNameMigrationVersion},
+			{Key: "migrated_at", Value: time.Now().UnixMilli()},
+		}},
+	}, options.Update().SetUpsert(true))
+	return err
+}
+
+// 캐릭터 이름 하나를 정규 키로 옮기고, 정규 키가 같은 다른 이름과 충돌했는지 반환합니다
+func (r *UserMongoRepository) migrateCharacterName(ctx context.Context, charName *entity.CharacterName) (bool, error) {
+	// 이전 버전의 _id는 입력한 이름 그대로이므로 표시용 이름으로 사용합니다
+	name := charName.Key
+	key := characterNameKey(name)
+
+	if key == name {
+		_, err := r.characterName.UpdateOne(ctx, bson.D{{Key: "_id", Value: name}}, bson.D{
+			{Key: "$set", Value: bson.D{
+				{Key: "name", Value: name},
+			}},
+		})
+		return false, err
+	}
+
+	migratedName := *charName
+	migratedName.Key = key
+	migratedName.Name = name
+
+	_, err := r.characterName.InsertOne(ctx, &migratedName)
+	if mongo.IsDuplicateKeyError(err) {
+		// 이전 실행에서 옮긴 뒤 중단된 경우가 아니라면 다른 이름과 충돌한 것입니다
+		var existing entity.CharacterName
+		if err := r.characterName.FindOne(ctx, bson.D{{Key: "_id", Value: key}}).Decode(&existing); err != nil {
+			return false, err
+		}
+
+		if existing.Name != name {
+			// 충돌한 이름은 이전 _id 그대로 두고 다시 검사하지 않도록 표시용 이름만 저장합니다
+			_, err := r.characterName.UpdateOne(ctx, bson.D{{Key: "_id", Value: name}}, bson.D{
+				{Key: "$set", Value: bson.D{
+					{Key: "name", Value: name},
+				}},
+			})
+			return true, err
+		}
+	} else if err != nil {
+		return false, err
+	}
+
+	_, err = r.characterName.DeleteOne(ctx, bson.D{{Key: "_id", Value: name}})
+	return false, err
+}
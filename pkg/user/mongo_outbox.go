@@ -0,0 +1,194 @@
+package user
+
+import (
+	"MScannot206/shared/entity"
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 캐릭터 작업 기록 종류
+const (
+	// 캐릭터 생성
+	characterOutboxCreate = "create_character"
+
+	// 캐릭터 삭제
+	characterOutboxDelete = "delete_character"
+
+	// 삭제된 캐릭터 복구
+	characterOutboxRestore = "restore_character"
+
+	// 캐릭터 이름 변경
+	characterOutboxRename = "rename_character"
+)
+
+// 캐릭터 작업 기록을 저장합니다. 반환되는 작업 기록은 전달한 작업 기록과 같은 순서입니다
+func (r *UserMongoRepository) insertCharacterOutboxes(ctx context.Context, outboxType string, outboxes []*entity.CharacterOutbox) ([]*entity.CharacterOutbox, error) {
+	now := time.Now().UTC()
+
+	docs := make([]any, 0, len(outboxes))
+	for _, outbox := range outboxes {
+		outbox.Id = primitive.NewObjectID().Hex()
+		outbox.Type = outboxType
+		outbox.CreatedAt = now
+		docs = append(docs, outbox)
+	}
+
+	if _, err := r.characterOutbox.InsertMany(ctx, docs); err != nil {
+		log.Err(err).Msg("캐릭터 작업 기록 저장 중 오류 발생")
+		return nil, err
+	}
+
+	return outboxes, nil
+}
+
+// 결과가 확정된 캐릭터 작업 기록을 삭제합니다
+// 삭제에 실패한 작업 기록은 복구 작업에서 현재 상태를 확인한 뒤 삭제되므로 로그만 남깁니다
+func (r *UserMongoRepository) deleteCharacterOutboxes(ctx context.Context, ids []string) {
+	if len(ids) == 0 {
+		return
+	}
+
+	filter := bson.D{
+		{Key: "_id", Value: bson.D{
+			{Key: "$in", Value: ids},
+		}},
+	}
+
+	if _, err := r.characterOutbox.DeleteMany(ctx, filter); err != nil {
+		log.Err(err).Msgf("캐릭터 작업 기록 삭제 실패: %v", ids)
+	}
+}
+
+// createdBefore 이전에 남은 캐릭터 작업 기록을 최대 limit개 복구하고 복구한 개수를 반환합니다
+// 캐릭터 생성이 끝나지 않았다면 차지한 캐릭터 이름을 되돌리고,
+// 캐릭터 삭제, 복구 후 캐릭터가 유저에게 남아 있다면 함께 남은 삭제된 캐릭터를 제거하고,
+// 캐릭터 이름 변경은 변경 여부에 따라 새 이름을 되돌리거나 이전 이름을 해제합니다
+func (r *UserMongoRepository) RecoverCharacterOutboxes(ctx context.Context, createdBefore time.Time, limit int64) (int, error) {
+	filter := bson.D{
+		{Key: "created_at", Value: bson.D{
+			{Key: "$lt", Value: createdBefore},
+		}},
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := r.characterOutbox.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	var outboxes []*entity.CharacterOutbox
+	if err := cursor.All(ctx, &outboxes); err != nil {
+		return 0, err
+	}
+
+	recoveredIds := make([]string, 0, len(outboxes))
+	for _, outbox := range outboxes {
+		if err := r.recoverCharacterOutbox(ctx, outbox); err != nil {
+			log.Err(err).Msgf("캐릭터 작업 기록 복구 실패: %v - %v", outbox.Id, outbox.Type)
+			continue
+		}
+		recoveredIds = append(recoveredIds, outbox.Id)
+	}
+
+	r.deleteCharacterOutboxes(ctx, recoveredIds)
+
+	return len(recoveredIds), nil
+}
+
+func (r *UserMongoRepository) recoverCharacterOutbox(ctx context.Context, outbox *entity.CharacterOutbox) error {
+	// 캐릭터 이름을 사용하는 캐릭터가 유저에게 있는지 확인 (삭제 후 같은 이름으로 다시 생성한 경우도 포함)
+	count, err := r.user.CountDocuments(ctx, bson.D{
+		{Key: "_id", Value: outbox.Uid},
+		{Key: "characters.name", Value: outbox.Name},
+	})
+	if err != nil {
+		return err
+	}
+	hasCharacter := count > 0
+
+	switch outbox.Type {
+	case characterOutboxCreate:
+		if hasCharacter {
+			// 캐릭터 생성 완료
+			return nil
+		}
+
+		// 삭제된 캐릭터가 사용 중인 이름은 되돌리지 않습니다
+		deletedCount, err := r.deletedCharacter.CountDocuments(ctx, bson.D{
+			{Key: "uid", Value: outbox.Uid},
+			{Key: "character.name", Value: outbox.Name},
+		})
+		if err != nil {
+			return err
+		}
+		if deletedCount > 0 {
+			return nil
+		}
+
+		// 캐릭터를 저장하지 못했으므로 유저가 차지한 캐릭터 이름을 되돌립니다 (예약 중인 이름은 그대로 둡니다)
+		_, err = r.characterName.DeleteOne(ctx, bson.D{
+			{Key: "_id", Value: characterNameKey(outbox.Name)},
+			{Key: "uid", Value: outbox.Uid},
+			{Key: "expire_at", Value: bson.D{
+				{Key: "$exists", Value: false},
+			}},
+		})
+		return err
+
+	case characterOutboxDelete, characterOutboxRestore:
+		if !hasCharacter {
+			// 캐릭터 삭제 완료 또는 복구 전 상태이므로 삭제된 캐릭터를 그대로 둡니다
+			return nil
+		}
+
+		// 캐릭터 삭제를 되돌렸거나 복구를 마쳤으므로 남은 삭제된 캐릭터를 제거합니다
+		_, err = r.deletedCharacter.DeleteMany(ctx, bson.D{
+			{Key: "uid", Value: outbox.Uid},
+			{Key: "character.name", Value: outbox.Name},
+		})
+		return err
+
+	case characterOutboxRename:
+		if characterNameKey(outbox.Name) == characterNameKey(outbox.OldName) {
+			// 표시용 이름만 바꾸므로 되돌리거나 해제할 이름이 없습니다
+			return nil
+		}
+
+		if !hasCharacter {
+			// 이름을 바꾸지 못했으므로 차지한 새 캐릭터 이름을 되돌립니다
+			_, err = r.characterName.DeleteOne(ctx, bson.D{
+				{Key: "_id", Value: characterNameKey(outbox.Name)},
+				{Key: "uid", Value: outbox.Uid},
+				{Key: "expire_at", Value: bson.D{
+					{Key: "$exists", Value: false},
+				}},
+			})
+			return err
+		}
+
+		// 이름을 바꿨으므로 이전 캐릭터 이름을 해제합니다 (같은 이름으로 다시 바꾼 경우는 제외)
+		oldCount, err := r.user.CountDocuments(ctx, bson.D{
+			{Key: "_id", Value: outbox.Uid},
+			{Key: "characters.name", Value: outbox.OldName},
+		})
+		if err != nil {
+			return err
+		}
+		if oldCount > 0 {
+			return nil
+		}
+		return r.releaseCharacterName(ctx, outbox.Uid, outbox.OldName)
+
+	default:
+		log.Warn().Msgf("알 수 없는 캐릭터 작업 기록: %v - %v", outbox.Id, outbox.Type)
+		return nil
+	}
+}
@@ -3,12 +3,16 @@ package user
 import (
 	"MScannot206/shared"
 	"MScannot206/shared/entity"
+	"MScannot206/shared/util"
 	"context"
 	"errors"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/rs/zerolog/log"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -32,22 +36,48 @@ func NewUserMongoRepository(
 	repo := &UserMongoRepository{
 		client: client,
 
-		user:          client.Database(dbName).Collection(shared.User),
-		characterName: client.Database(dbName).Collection(shared.CharacterName),
+		user:            client.Database(dbName).Collection(shared.User),
+		characterName:   client.Database(dbName).Collection(shared.CharacterName),
+		characterDraft:  client.Database(dbName).Collection(shared.CharacterDraft),
+		characterOutbox: client.Database(dbName).Collection(shared.CharacterOutbox),
+
+		deletedCharacter: client.Database(dbName).Collection(shared.DeletedCharacter),
+
+		migration: client.Database(dbName).Collection(shared.Migration),
 	}
 
 	if err := repo.ensureIndexes(ctx); err != nil {
 		return nil, err
 	}
 
+	if err := repo.migrateCharacterNames(ctx); err != nil {
+		return nil, err
+	}
+
+	repo.transactional = supportsTransaction(ctx, client)
+	if repo.transactional {
+		log.Info().Msg("유저 레포지토리: 트랜잭션으로 캐릭터를 생성, 삭제합니다")
+	} else {
+		log.Warn().Msg("유저 레포지토리: 트랜잭션을 사용할 수 없어 작업 기록으로 캐릭터 생성, 삭제를 복구합니다")
+	}
+
 	return repo, nil
 }
 
 type UserMongoRepository struct {
 	client *mongo.Client
 
-	user          *mongo.Collection
-	characterName *mongo.Collection
+	user            *mongo.Collection
+	characterName   *mongo.Collection
+	characterDraft  *mongo.Collection
+	characterOutbox *mongo.Collection
+
+	deletedCharacter *mongo.Collection
+
+	migration *mongo.Collection
+
+	// 멀티 도큐먼트 트랜잭션 사용 여부 (레플리카 셋, 샤드 클러스터에서만 사용할 수 있습니다)
+	transactional bool
 }
 
 func (r *UserMongoRepository) ensureIndexes(ctx context.Context) error {
@@ -71,7 +101,114 @@ func (r *UserMongoRepository) ensureIndexes(ctx context.Context) error {
 			SetName("user_character_name_idx"),
 	}
 
-	_, err := r.user.Indexes().CreateMany(ctx, []mongo.IndexModel{slotIndex, characterNameIndex})
+	// 이전 캐릭터 이름으로 캐릭터 추적
+	nameHistoryIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "characters.name_history.name", Value: 1},
+		},
+		Options: options.Index().
+			SetName("user_character_name_history_idx"),
+	}
+
+	_, err := r.user.Indexes().CreateMany(ctx, []mongo.IndexModel{slotIndex, characterNameIndex, nameHistoryIndex})
+	if err != nil {
+		return err
+	}
+
+	// 만료된 캐릭터 이름 예약 삭제 (사용 중인 이름은 expire_at이 없으므로 삭제되지 않습니다)
+	nameReserveTTLIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "expire_at", Value: 1},
+		},
+		Options: options.Index().
+			SetExpireAfterSeconds(0).
+			SetName("character_name_reserve_ttl_idx"),
+	}
+
+	// 유저의 캐릭터 이름 예약 조회
+	nameUidIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "uid", Value: 1},
+		},
+		Options: options.Index().
+			SetName("character_name_uid_idx"),
+	}
+
+	_, err = r.characterName.Indexes().CreateMany(ctx, []mongo.IndexModel{nameReserveTTLIndex, nameUidIndex})
+	if err != nil {
+		return err
+	}
+
+	// 캐릭터 생성 초안은 유저, 슬롯별로 하나만 존재합니다
+	draftSlotIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "uid", Value: 1},
+			{Key: "slot", Value: 1},
+		},
+		Options: options.Index().
+			SetUnique(true).
+			SetName("character_draft_uid_slot_idx"),
+	}
+
+	// 만료된 캐릭터 생성 초안 삭제
+	draftTTLIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "expire_at", Value: 1},
+		},
+		Options: options.Index().
+			SetExpireAfterSeconds(0).
+			SetName("character_draft_ttl_idx"),
+	}
+
+	_, err = r.characterDraft.Indexes().CreateMany(ctx, []mongo.IndexModel{draftSlotIndex, draftTTLIndex})
+	if err != nil {
+		return err
+	}
+
+	// 복구할 캐릭터 작업 기록 조회
+	outboxCreatedAtIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "created_at", Value: 1},
+		},
+		Options: options.Index().
+			SetName("character_outbox_created_at_idx"),
+	}
+
+	_, err = r.characterOutbox.Indexes().CreateOne(ctx, outboxCreatedAtIndex)
+	if err != nil {
+		return err
+	}
+
+	// 삭제된 캐릭터가 완전 삭제 전까지 슬롯을 차지합니다
+	deletedSlotIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "uid", Value: 1},
+			{Key: "character.slot", Value: 1},
+		},
+		Options: options.Index().
+			SetUnique(true).
+			SetName("deleted_character_uid_slot_idx"),
+	}
+
+	// 완전 삭제할 캐릭터 조회
+	deletedPurgeAtIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "purge_at", Value: 1},
+		},
+		Options: options.Index().
+			SetName("deleted_character_purge_at_idx"),
+	}
+
+	// 삭제된 캐릭터가 사용 중인 캐릭터 이름 조회
+	deletedNameIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "character.name", Value: 1},
+		},
+		Options: options.Index().
+			SetName("deleted_character_name_idx"),
+	}
+
+	_, err = r.deletedCharacter.Indexes().CreateMany(ctx, []mongo.IndexModel{deletedSlotIndex, deletedPurgeAtIndex, deletedNameIndex})
 	if err != nil {
 		return err
 	}
@@ -182,8 +319,33 @@ func (r *UserMongoRepository) ExistsCharacterNames(ctx context.Context, names []
 		existsMap[name] = false
 	}
 
+	charNames, err := r.FindCharacterNames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	for name := range charNames {
+		existsMap[name] = true
+	}
+
+	return existsMap, nil
+}
+
+// 사용 중이거나 예약 중인 캐릭터 이름을 조회합니다. TTL 인덱스로 삭제되기 전이라도 만료된 예약은 제외합니다
+// 정규 키로 조회하므로 대소문자나 전각 문자만 다른 이름도 찾으며, 반환하는 맵은 요청한 이름을 키로 사용합니다
+func (r *UserMongoRepository) FindCharacterNames(ctx context.Context, names []string) (map[string]*entity.CharacterName, error) {
+	namesByKey := make(map[string][]string, len(names))
+	for _, name := range names {
+		key := characterNameKey(name)
+		namesByKey[key] = append(namesByKey[key], name)
+	}
+
 	filter := bson.D{
-		{Key: "_id", Value: bson.D{{Key: "$in", Value: names}}},
+		{Key: "_id", Value: bson.D{{Key: "$in", Value: slices.Collect(maps.Keys(namesByKey))}}},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "expire_at", Value: bson.D{{Key: "$exists", Value: false}}}},
+			bson.D{{Key: "expire_at", Value: bson.D{{Key: "$gt", Value: time.Now().UTC()}}}},
+		}},
 	}
 
 	cursor, err := r.characterName.Find(ctx, filter)
@@ -197,188 +359,968 @@ func (r *UserMongoRepository) ExistsCharacterNames(ctx context.Context, names []
 		return nil, err
 	}
 
+	nameMap := make(map[string]*entity.CharacterName, len(names))
 	for _, cn := range charNames {
-		existsMap[cn.Name] = true
+		for _, name := range namesByKey[cn.Key] {
+			nameMap[name] = cn
+		}
 	}
 
-	return existsMap, nil
+	return nameMap, nil
+}
+
+// 캐릭터 이름의 정규 키를 만듭니다 (character_name 컬렉션의 _id)
+func characterNameKey(name string) string {
+	return util.CanonicalName(name)
+}
+
+// 유저가 캐릭터 이름을 차지할 수 있는 경우에만 일치하는 필터를 만듭니다
+// 이름이 없거나, 유저 자신의 예약이거나, 만료된 예약인 경우에만 일치하므로
+// upsert 시 일치하지 않으면 _id 중복 오류가 발생합니다
+func characterNameClaimFilter(uid string, name string, now time.Time) bson.D {
+	return bson.D{
+		{Key: "_id", Value: characterNameKey(name)},
+		{Key: "$or", Value: bson.A{
+			bson.D{
+				{Key: "uid", Value: uid},
+				{Key: "expire_at", Value: bson.D{{Key: "$exists", Value: true}}},
+			},
+			bson.D{{Key: "expire_at", Value: bson.D{{Key: "$lte", Value: now}}}},
+		}},
+	}
+}
+
+// 캐릭터 이름을 유저에게 expireAt까지 예약합니다. 유저 자신의 예약은 만료 일시를 연장합니다
+// 이미 사용 중이거나 다른 유저가 예약한 이름은 예약하지 못하며, 예약에 실패한 유저 고유 ID 목록을 반환합니다
+func (r *UserMongoRepository) ReserveCharacterNames(ctx context.Context, infos []*UserCheckCharacterName, expireAt time.Time) ([]string, error) {
+	if len(infos) == 0 {
+		return []string{}, nil
+	}
+
+	now := time.Now().UTC()
+	writeModels := make([]mongo.WriteModel, len(infos))
+	for i, info := range infos {
+		update := bson.D{
+			{Key: "$set", Value: bson.D{
+				{Key: "name", Value: info.Name},
+				{Key: "uid", Value: info.Uid},
+				{Key: "created_at", Value: now.UnixMilli()},
+				{Key: "expire_at", Value: expireAt},
+			}},
+		}
+
+		writeModels[i] = mongo.NewUpdateOneModel().
+			SetFilter(characterNameClaimFilter(info.Uid, info.Name, now)).
+			SetUpdate(update).
+			SetUpsert(true)
+	}
+
+	failedUids := make([]string, 0)
+	_, err := r.characterName.BulkWrite(ctx, writeModels, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		bulkErr, ok := err.(mongo.BulkWriteException)
+		if !ok {
+			return nil, err
+		}
+
+		for _, writeErr := range bulkErr.WriteErrors {
+			if !mongo.IsDuplicateKeyError(writeErr) {
+				return nil, err
+			}
+			failedUids = append(failedUids, infos[writeErr.Index].Uid)
+		}
+	}
+
+	// 유저당 하나의 이름만 예약할 수 있으므로 새로 예약한 유저의 이전 예약은 해제
+	releaseModels := make([]mongo.WriteModel, 0, len(infos))
+	for _, info := range infos {
+		if slices.Contains(failedUids, info.Uid) {
+			continue
+		}
+
+		filter := bson.D{
+			{Key: "_id", Value: bson.D{{Key: "$ne", Value: characterNameKey(info.Name)}}},
+			{Key: "uid", Value: info.Uid},
+			{Key: "expire_at", Value: bson.D{{Key: "$exists", Value: true}}},
+		}
+		releaseModels = append(releaseModels, mongo.NewDeleteManyModel().SetFilter(filter))
+	}
+
+	if len(releaseModels) > 0 {
+		if _, err := r.characterName.BulkWrite(ctx, releaseModels, options.BulkWrite().SetOrdered(false)); err != nil {
+			// 해제하지 못한 예약은 만료 시 TTL 인덱스로 삭제됩니다
+			log.Warn().Err(err).Msg("이전 캐릭터 이름 예약 해제 중 오류 발생")
+		}
+	}
+
+	return failedUids, nil
+}
+
+// 캐릭터가 없는 사용 중인 캐릭터 이름(롤백 실패 등으로 남은 이름)을 삭제합니다
+// after 이후의 이름을 정규 키 순으로 limit개 검사하며, createdBefore 이후에 사용된 이름은 생성 중일 수 있으므로 검사하지 않습니다
+// 마지막으로 검사한 이름의 정규 키와 삭제한 개수를 반환하며, 더 검사할 이름이 없으면 빈 문자열을 반환합니다
+func (r *UserMongoRepository) ReclaimOrphanCharacterNames(ctx context.Context, after string, createdBefore time.Time, limit int64) (string, int, error) {
+	filter := bson.D{
+		{Key: "_id", Value: bson.D{{Key: "$gt", Value: after}}},
+		{Key: "name", Value: bson.D{{Key: "$exists", Value: true}}},
+		{Key: "expire_at", Value: bson.D{{Key: "$exists", Value: false}}},
+		{Key: "created_at", Value: bson.D{{Key: "$lt", Value: createdBefore.UnixMilli()}}},
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := r.characterName.Find(ctx, filter, opts)
+	if err != nil {
+		return "", 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var charNames []*entity.CharacterName
+	if err := cursor.All(ctx, &charNames); err != nil {
+		return "", 0, err
+	}
+
+	if len(charNames) == 0 {
+		return "", 0, nil
+	}
+
+	names := make([]string, 0, len(charNames))
+	for _, cn := range charNames {
+		names = append(names, cn.Name)
+	}
+
+	// 캐릭터는 표시용 이름을 저장하므로 표시용 이름으로 사용 여부를 확인합니다
+	usedNames, err := r.user.Distinct(ctx, "characters.name", bson.D{
+		{Key: "characters.name", Value: bson.D{{Key: "$in", Value: names}}},
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	// 완전 삭제 전인 삭제된 캐릭터의 이름도 사용 중인 이름입니다
+	deletedNames, err := r.deletedCharacter.Distinct(ctx, "character.name", bson.D{
+		{Key: "character.name", Value: bson.D{{Key: "$in", Value: names}}},
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	usedNames = append(usedNames, deletedNames...)
+
+	candidates := make([]*entity.CharacterName, 0, len(charNames))
+	for _, cn := range charNames {
+		if !slices.Contains(usedNames, any(cn.Name)) {
+			candidates = append(candidates, cn)
+		}
+	}
+
+	// 표시용 이름만 바꾸는 중에는 캐릭터와 사용 중인 이름의 표시용 이름이 다를 수 있으므로
+	// 소유한 유저가 있는 이름은 유저의 캐릭터 이름과 정규 키로 다시 비교합니다
+	usedKeys, err := r.findUsedCharacterNameKeys(ctx, candidates)
+	if err != nil {
+		return "", 0, err
+	}
+
+	orphanKeys := make([]string, 0, len(candidates))
+	for _, cn := range candidates {
+		if cn.Uid != "" && slices.Contains(usedKeys[cn.Uid], characterNameKey(cn.Name)) {
+			continue
+		}
+		orphanKeys = append(orphanKeys, cn.Key)
+	}
+
+	lastKey := charNames[len(charNames)-1].Key
+	if int64(len(charNames)) < limit {
+		lastKey = ""
+	}
+
+	if len(orphanKeys) == 0 {
+		return lastKey, 0, nil
+	}
+
+	// 검사 중에 다시 사용되거나 예약된 이름은 삭제하지 않음
+	result, err := r.characterName.DeleteMany(ctx, bson.D{
+		{Key: "_id", Value: bson.D{{Key: "$in", Value: orphanKeys}}},
+		{Key: "expire_at", Value: bson.D{{Key: "$exists", Value: false}}},
+		{Key: "created_at", Value: bson.D{{Key: "$lt", Value: createdBefore.UnixMilli()}}},
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	return lastKey, int(result.DeletedCount), nil
+}
+
+// 캐릭터 이름을 소유한 유저별로 캐릭터와 완전 삭제 전인 삭제된 캐릭터 이름의 정규 키 목록을 조회합니다
+func (r *UserMongoRepository) findUsedCharacterNameKeys(ctx context.Context, charNames []*entity.CharacterName) (map[string][]string, error) {
+	uids := make([]string, 0, len(charNames))
+	for _, cn := range charNames {
+		if cn.Uid != "" && !slices.Contains(uids, cn.Uid) {
+			uids = append(uids, cn.Uid)
+		}
+	}
+
+	ret := make(map[string][]string, len(uids))
+	if len(uids) == 0 {
+		return ret, nil
+	}
+
+	cursor, err := r.user.Find(ctx, bson.D{
+		{Key: "_id", Value: bson.D{{Key: "$in", Value: uids}}},
+	}, options.Find().SetProjection(bson.D{{Key: "characters.name", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var users []*entity.User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		for _, character := range user.Characters {
+			ret[user.Uid] = append(ret[user.Uid], characterNameKey(character.Name))
+		}
+	}
+
+	cursor, err = r.deletedCharacter.Find(ctx, bson.D{
+		{Key: "uid", Value: bson.D{{Key: "$in", Value: uids}}},
+	}, options.Find().SetProjection(bson.D{
+		{Key: "uid", Value: 1},
+		{Key: "character.name", Value: 1},
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedCharacters []*entity.DeletedCharacter
+	if err := cursor.All(ctx, &deletedCharacters); err != nil {
+		return nil, err
+	}
+
+	for _, deleted := range deletedCharacters {
+		if deleted.Character != nil {
+			ret[deleted.Uid] = append(ret[deleted.Uid], characterNameKey(deleted.Character.Name))
+		}
+	}
+
+	return ret, nil
 }
 
+// 캐릭터 이름과 캐릭터를 함께 저장하여 캐릭터를 생성합니다
+// 트랜잭션을 사용할 수 있으면 유저별 트랜잭션으로, 아니면 작업 기록을 남기고 실패 시 되돌리는 방식으로 저장합니다
 func (r *UserMongoRepository) CreateCharacters(ctx context.Context, infos []*UserCreateCharacter) (map[string]*entity.Character, map[string]string, error) {
 	if len(infos) == 0 {
 		return map[string]*entity.Character{}, map[string]string{}, nil
 	}
 
+	if r.transactional {
+		return r.createCharactersInTransaction(ctx, infos)
+	}
+	return r.createCharactersWithOutbox(ctx, infos)
+}
+
+// 캐릭터 이름을 유저가 사용 중인 이름으로 변경하는 업데이트를 만듭니다
+// characterNameClaimFilter와 함께 사용하며, 유저 자신의 예약은 사용 중인 이름으로 바뀝니다 (표시용 이름은 새 이름으로 바뀝니다)
+func characterNameClaimUpdate(uid string, name string, now time.Time) bson.D {
+	return bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "name", Value: name},
+			{Key: "uid", Value: uid},
+			{Key: "created_at", Value: now.UnixMilli()},
+		}},
+		{Key: "$unset", Value: bson.D{
+			{Key: "expire_at", Value: ""},
+		}},
+	}
+}
+
+func newCharacterByCreateInfo(info *UserCreateCharacter) *entity.Character {
+	return &entity.Character{
+		Slot:   info.Slot,
+		Name:   info.Name,
+		Gender: info.Gender,
+		Equips: info.Equips,
+	}
+}
+
+// 작업 기록을 남긴 뒤 캐릭터 이름과 캐릭터를 차례로 저장하고, 캐릭터 저장에 실패하면 캐릭터 이름을 되돌립니다
+// 되돌리지 못했거나 도중에 중단된 작업은 남은 작업 기록으로 복구합니다 (RecoverCharacterOutboxes)
+func (r *UserMongoRepository) createCharactersWithOutbox(ctx context.Context, infos []*UserCreateCharacter) (map[string]*entity.Character, map[string]string, error) {
+	outboxes, err := r.insertCharacterOutboxes(ctx, characterOutboxCreate, func() []*entity.CharacterOutbox {
+		outboxes := make([]*entity.CharacterOutbox, 0, len(infos))
+		for _, info := range infos {
+			outboxes = append(outboxes, &entity.CharacterOutbox{Uid: info.Uid, Slot: info.Slot, Name: info.Name})
+		}
+		return outboxes
+	}())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 결과가 확정되어 복구할 필요가 없는 작업 기록
+	resolvedOutboxIds := make([]string, 0, len(infos))
 	failureUids := make(map[string]string, len(infos))
-	userRequests := make(map[string]*UserCreateCharacter, len(infos))
 	charNameModels := make([]mongo.WriteModel, len(infos))
+	now := time.Now().UTC()
 
 	for i, info := range infos {
-		userRequests[info.Uid] = info
-
-		doc := &entity.CharacterName{
-			Name:      info.Name,
-			CreatedAt: time.Now().UTC().UnixMilli(),
-		}
-		charNameModels[i] = mongo.NewInsertOneModel().SetDocument(doc)
+		// 이름이 비어 있거나 유저 자신이 예약한 이름인 경우에만 사용 중인 이름으로 변경 (다른 유저의 예약은 _id 중복으로 실패)
+		charNameModels[i] = mongo.NewUpdateOneModel().
+			SetFilter(characterNameClaimFilter(info.Uid, info.Name, now)).
+			SetUpdate(characterNameClaimUpdate(info.Uid, info.Name, now)).
+			SetUpsert(true)
 	}
 
-	_, err := r.characterName.BulkWrite(ctx, charNameModels, options.BulkWrite().SetOrdered(false))
+	_, err = r.characterName.BulkWrite(ctx, charNameModels, options.BulkWrite().SetOrdered(false))
 	if err != nil {
 		if bulkErr, ok := err.(mongo.BulkWriteException); ok {
 			for _, writeErr := range bulkErr.WriteErrors {
 				uid := infos[writeErr.Index].Uid
 				if mongo.IsDuplicateKeyError(writeErr) {
 					failureUids[uid] = USER_CHARACTER_NAME_ALREADY_EXISTS_ERROR
+					resolvedOutboxIds = append(resolvedOutboxIds, outboxes[writeErr.Index].Id)
+				} else {
+					failureUids[uid] = USER_CREATE_CHARACTER_DB_WRITE_ERROR
 				}
-				delete(userRequests, infos[writeErr.Index].Uid)
 			}
 		} else {
 			return nil, nil, err
 		}
 	}
 
-	createInfos := make([]*UserCreateCharacter, 0, len(userRequests))
-	for _, info := range userRequests {
-		createInfos = append(createInfos, info)
+	// 캐릭터 이름을 차지한 요청만 캐릭터 저장
+	createIndexes := make([]int, 0, len(infos))
+	for i, info := range infos {
+		if _, failed := failureUids[info.Uid]; !failed {
+			createIndexes = append(createIndexes, i)
+		}
 	}
 
-	createdCharacters := make(map[string]*entity.Character, len(createInfos))
-	removeCharNameModels := make([]mongo.WriteModel, 0, len(createInfos))
-	newCharModels := make([]mongo.WriteModel, len(createInfos))
+	// 캐릭터 저장에 실패하여 되돌려야 하는 캐릭터 이름
+	rollbackIndexes := make([]int, 0)
+	createdCharacters := make(map[string]*entity.Character, len(createIndexes))
 
-	for i, info := range createInfos {
-		newCharacter := &entity.Character{
-			Slot:   info.Slot,
-			Name:   info.Name,
-			Gender: info.Gender,
-		}
+	// 슬롯이 비어 있는 경우에만 저장해야 하므로 유저별로 저장하여 결과를 확인합니다 (트랜잭션과 같은 조건)
+	for _, infoIndex := range createIndexes {
+		info := infos[infoIndex]
+		newCharacter := newCharacterByCreateInfo(info)
 
+		filter := bson.D{
+			{Key: "_id", Value: info.Uid},
+			{Key: "characters.slot", Value: bson.D{
+				{Key: "$ne", Value: info.Slot},
+			}},
+		}
 		update := bson.D{
 			{Key: "$push", Value: bson.D{
 				{Key: "characters", Value: newCharacter},
 			}},
 		}
-		filter := bson.D{
-			{Key: "_id", Value: info.Uid},
-		}
-
-		createdCharacters[info.Uid] = newCharacter
-		newCharModels[i] = mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(update).SetUpsert(false)
-	}
 
-	if len(newCharModels) > 0 {
-		_, err = r.user.BulkWrite(ctx, newCharModels, options.BulkWrite().SetOrdered(false))
+		result, err := r.user.UpdateOne(ctx, filter, update)
 		if err != nil {
-			if bulkErr, ok := err.(mongo.BulkWriteException); ok {
-				for _, writeErr := range bulkErr.WriteErrors {
-					info := createInfos[writeErr.Index]
-					delete(createdCharacters, info.Uid)
+			failureUids[info.Uid] = USER_CREATE_CHARACTER_DB_WRITE_ERROR
 
-					opts := mongo.NewDeleteOneModel().SetFilter(bson.D{
-						{Key: "_id", Value: info.Name},
-					})
-					removeCharNameModels = append(removeCharNameModels, opts)
-				}
+			var writeErr mongo.WriteException
+			if errors.As(err, &writeErr) {
+				rollbackIndexes = append(rollbackIndexes, infoIndex)
 			} else {
-				// 캐릭터 생성 중 오류가 발생함... CS처리가 필요 할 수 있음
-				// TODO:특수 상황 로그 남기기
-				log.Err(err)
-				return nil, nil, err
+				// 저장 여부를 알 수 없으므로 작업 기록을 남겨 복구 작업에서 결과를 확인하여 처리합니다
+				log.Err(err).Msgf("캐릭터 생성 중 오류 발생: %v", info.Uid)
 			}
+			continue
 		}
+
+		if result.MatchedCount == 0 {
+			failureUids[info.Uid] = USER_CHARACTER_SLOT_ALREADY_EXISTS_ERROR
+			rollbackIndexes = append(rollbackIndexes, infoIndex)
+			continue
+		}
+
+		createdCharacters[info.Uid] = newCharacter
+		resolvedOutboxIds = append(resolvedOutboxIds, outboxes[infoIndex].Id)
 	}
 
-	if len(removeCharNameModels) > 0 {
+	if len(rollbackIndexes) > 0 {
+		removeCharNameModels := make([]mongo.WriteModel, len(rollbackIndexes))
+		for i, infoIndex := range rollbackIndexes {
+			removeCharNameModels[i] = mongo.NewDeleteOneModel().SetFilter(bson.D{
+				{Key: "_id", Value: characterNameKey(infos[infoIndex].Name)},
+				{Key: "uid", Value: infos[infoIndex].Uid},
+			})
+		}
+
+		failedRollbacks := make(map[int]struct{})
 		_, err = r.characterName.BulkWrite(ctx, removeCharNameModels, options.BulkWrite().SetOrdered(false))
 		if err != nil {
 			if bulkErr, ok := err.(mongo.BulkWriteException); ok {
 				log.Warn().Msg("일부 캐릭터 이름 삭제에 실패했습니다")
 				for _, writeErr := range bulkErr.WriteErrors {
-					log.Warn().Msgf("캐릭터 이름 삭제 실패: %v - %v", createInfos[writeErr.Index].Name, writeErr.Message)
+					failedRollbacks[writeErr.Index] = struct{}{}
+					log.Warn().Msgf("캐릭터 이름 삭제 실패: %v - %v", infos[rollbackIndexes[writeErr.Index]].Name, writeErr.Message)
+				}
+			} else {
+				log.Err(err).Msg("캐릭터 이름 삭제 중 오류 발생")
+				for i := range rollbackIndexes {
+					failedRollbacks[i] = struct{}{}
 				}
 			}
-			log.Err(err).Msg("캐릭터 이름 삭제 중 오류 발생")
+		}
+
+		for i, infoIndex := range rollbackIndexes {
+			if _, failed := failedRollbacks[i]; !failed {
+				resolvedOutboxIds = append(resolvedOutboxIds, outboxes[infoIndex].Id)
+			}
 		}
 	}
 
+	r.deleteCharacterOutboxes(ctx, resolvedOutboxIds)
+
 	return createdCharacters, failureUids, nil
 }
 
-func (r *UserMongoRepository) DeleteCharacters(ctx context.Context, infos []*UserDeleteCharacter) ([]string, error) {
+// 캐릭터를 삭제된 캐릭터로 옮깁니다. 캐릭터 이름과 슬롯은 purgeAt까지 유지되며 그 전까지 복구할 수 있습니다
+// 트랜잭션을 사용할 수 있으면 유저별 트랜잭션으로, 아니면 작업 기록을 남기고 실패 시 되돌리는 방식으로 처리합니다
+func (r *UserMongoRepository) DeleteCharacters(ctx context.Context, infos []*UserDeleteCharacter, purgeAt time.Time) (map[string]*entity.DeletedCharacter, map[string]string, error) {
+	deletedCharacters := make(map[string]*entity.DeletedCharacter, len(infos))
+	failureUids := make(map[string]string)
 	if len(infos) == 0 {
-		return []string{}, nil
+		return deletedCharacters, failureUids, nil
 	}
 
-	successUids := make(map[string]string, len(infos))
-	writeModels := make([]mongo.WriteModel, len(infos))
-	for i, info := range infos {
-		filter := bson.D{
-			{Key: "_id", Value: info.Uid},
-		}
-		update := bson.D{
-			{Key: "$pull", Value: bson.D{
-				{Key: "characters", Value: bson.D{
-					{Key: "slot", Value: info.Slot},
-				}},
-			}},
+	var outboxes []*entity.CharacterOutbox
+	if !r.transactional {
+		var err error
+		outboxes, err = r.insertCharacterOutboxes(ctx, characterOutboxDelete, func() []*entity.CharacterOutbox {
+			outboxes := make([]*entity.CharacterOutbox, 0, len(infos))
+			for _, info := range infos {
+				outboxes = append(outboxes, &entity.CharacterOutbox{Uid: info.Uid, Slot: info.Slot, Name: info.Name})
+			}
+			return outboxes
+		}())
+		if err != nil {
+			return nil, nil, err
 		}
-
-		successUids[info.Uid] = info.Name
-		writeModels[i] = mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(update).SetUpsert(false)
 	}
 
-	_, err := r.user.BulkWrite(ctx, writeModels, options.BulkWrite().SetOrdered(false))
-	if err != nil {
-		if bulkErr, ok := err.(mongo.BulkWriteException); ok {
-			log.Warn().Msg("일부 캐릭터 제거에 실패했습니다")
-			for _, writeErr := range bulkErr.WriteErrors {
-				log.Warn().Msgf("캐릭터 제거 실패: %v", writeErr.Message)
-				delete(successUids, infos[writeErr.Index].Uid)
-			}
-		} else {
-			log.Error().Msg("캐릭터 제거 중 오류 발생")
+	// 결과가 확정되어 복구할 필요가 없는 작업 기록
+	resolvedOutboxIds := make([]string, 0, len(outboxes))
+	now := time.Now().UTC()
+
+	for i, info := range infos {
+		var deleted *entity.DeletedCharacter
+		err := r.runAtomic(ctx, func(ctx context.Context) error {
+			var err error
+			deleted, err = r.moveCharacterToDeleted(ctx, info, now, purgeAt)
+			return err
+		})
+
+		switch {
+		case err == nil:
+			deletedCharacters[info.Uid] = deleted
+		case errors.Is(err, errCharacterSlotUnavailable):
+			failureUids[info.Uid] = USER_DELETE_CHARACTER_SLOT_NOT_FOUND_ERROR
+		case mongo.IsDuplicateKeyError(err):
+			failureUids[info.Uid] = USER_CHARACTER_SLOT_DELETE_PENDING_ERROR
+		default:
+			// 작업 기록이 남아 있으므로 복구 작업에서 결과를 확인하여 처리합니다
+			log.Err(err).Msgf("캐릭터 삭제 실패: %v - %v", info.Uid, info.Slot)
+			failureUids[info.Uid] = USER_DELETE_CHARACTER_DB_WRITE_ERROR
+			continue
+		}
+
+		if outboxes != nil {
+			resolvedOutboxIds = append(resolvedOutboxIds, outboxes[i].Id)
+		}
+	}
+
+	r.deleteCharacterOutboxes(ctx, resolvedOutboxIds)
+
+	return deletedCharacters, failureUids, nil
+}
+
+// 유저의 캐릭터를 삭제된 캐릭터로 저장한 뒤 유저에게서 제거합니다
+// 저장 이후 캐릭터가 바뀌어 제거하지 못하면 저장한 삭제된 캐릭터를 되돌리고 errCharacterSlotUnavailable을 반환합니다
+func (r *UserMongoRepository) moveCharacterToDeleted(ctx context.Context, info *UserDeleteCharacter, now time.Time, purgeAt time.Time) (*entity.DeletedCharacter, error) {
+	var user entity.User
+	err := r.user.FindOne(ctx, bson.D{
+		{Key: "_id", Value: info.Uid},
+	}, options.FindOne().SetProjection(bson.D{
+		{Key: "characters", Value: 1},
+	})).Decode(&user)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errCharacterSlotUnavailable
+		}
+		return nil, err
+	}
+
+	character := findCharacterBySlot(user.Characters, info.Slot)
+	if character == nil || character.Name != info.Name {
+		return nil, errCharacterSlotUnavailable
+	}
+
+	deleted := &entity.DeletedCharacter{
+		Id:        primitive.NewObjectID().Hex(),
+		Uid:       info.Uid,
+		Character: character,
+		DeletedAt: now,
+		PurgeAt:   purgeAt,
+	}
+
+	if _, err := r.deletedCharacter.InsertOne(ctx, deleted); err != nil {
+		return nil, err
+	}
+
+	filter := bson.D{
+		{Key: "_id", Value: info.Uid},
+		{Key: "characters", Value: bson.D{
+			{Key: "$elemMatch", Value: bson.D{
+				{Key: "slot", Value: info.Slot},
+				{Key: "name", Value: info.Name},
+			}},
+		}},
+	}
+	update := bson.D{
+		{Key: "$pull", Value: bson.D{
+			{Key: "characters", Value: bson.D{
+				{Key: "slot", Value: info.Slot},
+			}},
+		}},
+	}
+
+	result, err := r.user.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MatchedCount == 0 {
+		if _, err := r.deletedCharacter.DeleteOne(ctx, bson.D{{Key: "_id", Value: deleted.Id}}); err != nil {
 			return nil, err
 		}
+		return nil, errCharacterSlotUnavailable
 	}
 
-	// 캐릭터 삭제에 성공하였다면 캐릭터 이름도 삭제
-	if len(successUids) == 0 {
-		return []string{}, nil
+	return deleted, nil
+}
+
+// 삭제된 캐릭터를 원래 슬롯으로 복구합니다
+// 트랜잭션을 사용할 수 있으면 유저별 트랜잭션으로, 아니면 작업 기록을 남기는 방식으로 처리합니다
+func (r *UserMongoRepository) RestoreCharacters(ctx context.Context, infos []*UserRestoreCharacter) (map[string]*entity.Character, map[string]string, error) {
+	restoredCharacters := make(map[string]*entity.Character, len(infos))
+	failureUids := make(map[string]string)
+	if len(infos) == 0 {
+		return restoredCharacters, failureUids, nil
+	}
+
+	now := time.Now().UTC()
+
+	// 작업 기록에 캐릭터 이름을 남기기 위해 복구할 삭제된 캐릭터를 먼저 조회
+	deletedCharacters, err := r.FindDeletedCharacters(ctx, func() []string {
+		uids := make([]string, 0, len(infos))
+		for _, info := range infos {
+			uids = append(uids, info.Uid)
+		}
+		return uids
+	}())
+	if err != nil {
+		return nil, nil, err
 	}
 
-	deleteNames := make([]string, 0, len(successUids))
-	for _, name := range successUids {
-		deleteNames = append(deleteNames, name)
+	restoreInfos := make([]*UserRestoreCharacter, 0, len(infos))
+	for _, info := range infos {
+		if findDeletedCharacterBySlot(deletedCharacters[info.Uid], info.Slot) == nil {
+			failureUids[info.Uid] = USER_RESTORE_CHARACTER_NOT_FOUND_ERROR
+			continue
+		}
+		restoreInfos = append(restoreInfos, info)
 	}
-	deleteCharNameModels := make([]mongo.WriteModel, 0, len(successUids))
 
-	for _, name := range deleteNames {
-		filter := bson.D{
-			{Key: "_id", Value: name},
+	var outboxes []*entity.CharacterOutbox
+	if !r.transactional && len(restoreInfos) > 0 {
+		outboxes, err = r.insertCharacterOutboxes(ctx, characterOutboxRestore, func() []*entity.CharacterOutbox {
+			outboxes := make([]*entity.CharacterOutbox, 0, len(restoreInfos))
+			for _, info := range restoreInfos {
+				deleted := findDeletedCharacterBySlot(deletedCharacters[info.Uid], info.Slot)
+				outboxes = append(outboxes, &entity.CharacterOutbox{Uid: info.Uid, Slot: info.Slot, Name: deleted.Character.Name})
+			}
+			return outboxes
+		}())
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// 결과가 확정되어 복구할 필요가 없는 작업 기록
+	resolvedOutboxIds := make([]string, 0, len(outboxes))
+
+	for i, info := range restoreInfos {
+		var restored *entity.Character
+		err := r.runAtomic(ctx, func(ctx context.Context) error {
+			var err error
+			restored, err = r.moveDeletedToCharacter(ctx, info, now)
+			return err
+		})
+
+		switch {
+		case err == nil:
+			restoredCharacters[info.Uid] = restored
+		case errors.Is(err, errDeletedCharacterNotFound):
+			failureUids[info.Uid] = USER_RESTORE_CHARACTER_NOT_FOUND_ERROR
+		case errors.Is(err, errCharacterSlotUnavailable):
+			failureUids[info.Uid] = USER_CHARACTER_SLOT_ALREADY_EXISTS_ERROR
+		default:
+			// 작업 기록이 남아 있으므로 복구 작업에서 결과를 확인하여 처리합니다
+			log.Err(err).Msgf("캐릭터 복구 실패: %v - %v", info.Uid, info.Slot)
+			failureUids[info.Uid] = USER_RESTORE_CHARACTER_DB_WRITE_ERROR
+			continue
 		}
 
-		deleteCharNameModels = append(deleteCharNameModels, mongo.NewDeleteOneModel().SetFilter(filter))
+		if outboxes != nil {
+			resolvedOutboxIds = append(resolvedOutboxIds, outboxes[i].Id)
+		}
 	}
 
-	_, err = r.characterName.BulkWrite(ctx, deleteCharNameModels, options.BulkWrite().SetOrdered(false))
+	r.deleteCharacterOutboxes(ctx, resolvedOutboxIds)
+
+	return restoredCharacters, failureUids, nil
+}
+
+// 완전 삭제 전인 삭제된 캐릭터를 유저에게 다시 추가한 뒤 삭제된 캐릭터에서 제거합니다
+func (r *UserMongoRepository) moveDeletedToCharacter(ctx context.Context, info *UserRestoreCharacter, now time.Time) (*entity.Character, error) {
+	var deleted entity.DeletedCharacter
+	err := r.deletedCharacter.FindOne(ctx, bson.D{
+		{Key: "uid", Value: info.Uid},
+		{Key: "character.slot", Value: info.Slot},
+		{Key: "purge_at", Value: bson.D{
+			{Key: "$gt", Value: now},
+		}},
+	}).Decode(&deleted)
 	if err != nil {
-		if bulkErr, ok := err.(mongo.BulkWriteException); ok {
-			log.Warn().Msg("일부 캐릭터 이름 삭제에 실패했습니다")
-			for _, writeErr := range bulkErr.WriteErrors {
-				// 삭제에 실패한 캐릭터 이름은 별도로 삭제를 해줘야 함...
-				log.Warn().Msgf("캐릭터 이름 삭제 실패: %v - %v", deleteNames[writeErr.Index], writeErr.Message)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errDeletedCharacterNotFound
+		}
+		return nil, err
+	}
+
+	filter := bson.D{
+		{Key: "_id", Value: info.Uid},
+		{Key: "characters.slot", Value: bson.D{
+			{Key: "$ne", Value: info.Slot},
+		}},
+	}
+	update := bson.D{
+		{Key: "$push", Value: bson.D{
+			{Key: "characters", Value: deleted.Character},
+		}},
+	}
+
+	result, err := r.user.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return nil, err
+	}
+	if result.MatchedCount == 0 {
+		return nil, errCharacterSlotUnavailable
+	}
+
+	if _, err := r.deletedCharacter.DeleteOne(ctx, bson.D{{Key: "_id", Value: deleted.Id}}); err != nil {
+		return nil, err
+	}
+
+	return deleted.Character, nil
+}
+
+// 캐릭터 이름을 변경합니다. 새 이름을 차지한 뒤 캐릭터 이름을 바꾸고 이전 이름을 해제하며, 이전 이름은 캐릭터의 이름 기록에 남깁니다
+// 트랜잭션을 사용할 수 있으면 유저별 트랜잭션으로, 아니면 작업 기록을 남기고 실패 시 되돌리는 방식으로 처리합니다
+func (r *UserMongoRepository) RenameCharacters(ctx context.Context, infos []*UserRenameCharacter, now time.Time) (map[string]string, error) {
+	failureUids := make(map[string]string)
+	if len(infos) == 0 {
+		return failureUids, nil
+	}
+
+	var outboxes []*entity.CharacterOutbox
+	if !r.transactional {
+		var err error
+		outboxes, err = r.insertCharacterOutboxes(ctx, characterOutboxRename, func() []*entity.CharacterOutbox {
+			outboxes := make([]*entity.CharacterOutbox, 0, len(infos))
+			for _, info := range infos {
+				outboxes = append(outboxes, &entity.CharacterOutbox{Uid: info.Uid, Slot: info.Slot, Name: info.Name, OldName: info.OldName})
 			}
+			return outboxes
+		}())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 결과가 확정되어 복구할 필요가 없는 작업 기록
+	resolvedOutboxIds := make([]string, 0, len(outboxes))
+
+	for i, info := range infos {
+		err := r.runAtomic(ctx, func(ctx context.Context) error {
+			return r.renameCharacter(ctx, info, now)
+		})
+
+		switch {
+		case err == nil:
+		case mongo.IsDuplicateKeyError(err):
+			failureUids[info.Uid] = USER_CHARACTER_NAME_ALREADY_EXISTS_ERROR
+		case errors.Is(err, errCharacterSlotUnavailable):
+			failureUids[info.Uid] = USER_RENAME_CHARACTER_SLOT_NOT_FOUND_ERROR
+		default:
+			// 작업 기록이 남아 있으므로 복구 작업에서 결과를 확인하여 처리합니다
+			log.Err(err).Msgf("캐릭터 이름 변경 실패: %v - %v", info.Uid, info.Slot)
+			failureUids[info.Uid] = USER_RENAME_CHARACTER_DB_WRITE_ERROR
+			continue
+		}
+
+		if outboxes != nil {
+			resolvedOutboxIds = append(resolvedOutboxIds, outboxes[i].Id)
+		}
+	}
+
+	r.deleteCharacterOutboxes(ctx, resolvedOutboxIds)
+
+	return failureUids, nil
+}
+
+// 새 캐릭터 이름을 차지하고 캐릭터 이름을 바꾼 뒤 이전 캐릭터 이름을 해제합니다
+// 캐릭터가 바뀌어 이름을 바꾸지 못하면 차지한 새 이름을 되돌리고 errCharacterSlotUnavailable을 반환합니다
+// 대소문자나 전각 문자만 바꾸는 경우(정규 키가 같은 경우)는 사용 중인 이름의 표시용 이름만 바꿉니다
+func (r *UserMongoRepository) renameCharacter(ctx context.Context, info *UserRenameCharacter, now time.Time) error {
+	if characterNameKey(info.Name) == characterNameKey(info.OldName) {
+		return r.renameCharacterDisplayName(ctx, info, now)
+	}
+
+	_, err := r.characterName.UpdateOne(
+		ctx,
+		characterNameClaimFilter(info.Uid, info.Name, now),
+		characterNameClaimUpdate(info.Uid, info.Name, now),
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.user.UpdateOne(ctx, characterRenameFilter(info), characterRenameUpdate(info, now))
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		_, err := r.characterName.DeleteOne(ctx, bson.D{
+			{Key: "_id", Value: characterNameKey(info.Name)},
+			{Key: "uid", Value: info.Uid},
+		})
+		if err != nil {
+			return err
 		}
-		log.Err(err).Msg("캐릭터 이름 삭제 중 오류 발생")
+		return errCharacterSlotUnavailable
+	}
+
+	return r.releaseCharacterName(ctx, info.Uid, info.OldName)
+}
+
+// 정규 키가 같은 이름으로 캐릭터 이름과 사용 중인 이름의 표시용 이름을 바꿉니다
+func (r *UserMongoRepository) renameCharacterDisplayName(ctx context.Context, info *UserRenameCharacter, now time.Time) error {
+	result, err := r.user.UpdateOne(ctx, characterRenameFilter(info), characterRenameUpdate(info, now))
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return errCharacterSlotUnavailable
+	}
+
+	_, err = r.characterName.UpdateOne(ctx, bson.D{
+		{Key: "_id", Value: characterNameKey(info.Name)},
+		{Key: "expire_at", Value: bson.D{
+			{Key: "$exists", Value: false},
+		}},
+	}, bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "name", Value: info.Name},
+			{Key: "uid", Value: info.Uid},
+		}},
+	})
+	return err
+}
+
+// 이름을 바꿀 캐릭터를 찾는 필터를 만듭니다 (슬롯의 캐릭터 이름이 이전 이름과 같아야 합니다)
+func characterRenameFilter(info *UserRenameCharacter) bson.D {
+	return bson.D{
+		{Key: "_id", Value: info.Uid},
+		{Key: "characters", Value: bson.D{
+			{Key: "$elemMatch", Value: bson.D{
+				{Key: "slot", Value: info.Slot},
+				{Key: "name", Value: info.OldName},
+			}},
+		}},
+	}
+}
+
+// 캐릭터 이름을 바꾸고 이전 이름을 이름 변경 기록에 남기는 업데이트를 만듭니다
+func characterRenameUpdate(info *UserRenameCharacter, now time.Time) bson.D {
+	return bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "characters.$.name", Value: info.Name},
+		}},
+		{Key: "$push", Value: bson.D{
+			{Key: "characters.$.name_history", Value: &entity.CharacterNameHistory{
+				Name:      info.OldName,
+				ChangedAt: now,
+			}},
+		}},
+	}
+}
+
+// 유저가 사용하던 캐릭터 이름을 해제합니다
+// uid가 없는 이름은 이전 버전에서 생성된 이름이므로 표시용 이름까지 같아야 해제합니다
+// 정규 키 변환 시 충돌하여 입력한 이름 그대로를 _id로 남긴 이름도 함께 해제합니다
+func (r *UserMongoRepository) releaseCharacterName(ctx context.Context, uid string, name string) error {
+	key := characterNameKey(name)
+	ids := bson.A{key}
+	if key != name {
+		ids = append(ids, name)
+	}
+
+	_, err := r.characterName.DeleteMany(ctx, bson.D{
+		{Key: "_id", Value: bson.D{
+			{Key: "$in", Value: ids},
+		}},
+		{Key: "expire_at", Value: bson.D{
+			{Key: "$exists", Value: false},
+		}},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "uid", Value: uid}},
+			bson.D{
+				{Key: "uid", Value: bson.D{{Key: "$exists", Value: false}}},
+				{Key: "name", Value: name},
+			},
+		}},
+	})
+	return err
+}
+
+// 완전 삭제 전인 유저별 삭제된 캐릭터 목록을 조회합니다
+func (r *UserMongoRepository) FindDeletedCharacters(ctx context.Context, uids []string) (map[string][]*entity.DeletedCharacter, error) {
+	return r.findDeletedCharacters(ctx, uids, false)
+}
+
+// 유예 기간이 지났지만 아직 완전 삭제되지 않은 캐릭터까지 포함하여 유저별 삭제된 캐릭터 목록을 조회합니다
+// 완전 삭제 작업이 인벤토리를 정리하는 동안 같은 슬롯에 새 캐릭터가 생성되지 않도록 슬롯 확인에 사용합니다
+func (r *UserMongoRepository) FindSlotDeletedCharacters(ctx context.Context, uids []string) (map[string][]*entity.DeletedCharacter, error) {
+	return r.findDeletedCharacters(ctx, uids, true)
+}
+
+func (r *UserMongoRepository) findDeletedCharacters(ctx context.Context, uids []string, includeExpired bool) (map[string][]*entity.DeletedCharacter, error) {
+	ret := make(map[string][]*entity.DeletedCharacter, len(uids))
+	if len(uids) == 0 {
+		return ret, nil
+	}
+
+	filter := bson.D{
+		{Key: "uid", Value: bson.D{
+			{Key: "$in", Value: uids},
+		}},
+	}
+	if !includeExpired {
+		filter = append(filter, bson.E{Key: "purge_at", Value: bson.D{
+			{Key: "$gt", Value: time.Now().UTC()},
+		}})
+	}
+
+	cursor, err := r.deletedCharacter.Find(ctx, filter)
+	if err != nil {
+		return nil, err
 	}
-	return func() []string {
-		successUidsList := make([]string, 0, len(successUids))
-		for uid := range successUids {
-			successUidsList = append(successUidsList, uid)
+
+	var deletedCharacters []*entity.DeletedCharacter
+	if err := cursor.All(ctx, &deletedCharacters); err != nil {
+		return nil, err
+	}
+
+	for _, deleted := range deletedCharacters {
+		ret[deleted.Uid] = append(ret[deleted.Uid], deleted)
+	}
+
+	return ret, nil
+}
+
+// 유예 기간이 지난 삭제된 캐릭터를 완전 삭제할 순서대로 최대 limit개 조회합니다
+func (r *UserMongoRepository) FindExpiredDeletedCharacters(ctx context.Context, now time.Time, limit int64) ([]*entity.DeletedCharacter, error) {
+	filter := bson.D{
+		{Key: "purge_at", Value: bson.D{
+			{Key: "$lte", Value: now},
+		}},
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "purge_at", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := r.deletedCharacter.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var expired []*entity.DeletedCharacter
+	if err := cursor.All(ctx, &expired); err != nil {
+		return nil, err
+	}
+
+	return expired, nil
+}
+
+// 유예 기간이 지난 삭제된 캐릭터 기록을 삭제하고 캐릭터 이름을 해제합니다
+// 완전 삭제된 캐릭터 목록을 반환하며, 캐릭터 이름 해제에 실패한 경우 캐릭터 이름 정리 작업에서 다시 해제됩니다
+func (r *UserMongoRepository) PurgeDeletedCharacters(ctx context.Context, expired []*entity.DeletedCharacter, now time.Time) []*entity.DeletedCharacter {
+	purged := make([]*entity.DeletedCharacter, 0, len(expired))
+	for _, deleted := range expired {
+		err := r.runAtomic(ctx, func(ctx context.Context) error {
+			return r.purgeDeletedCharacter(ctx, deleted, now)
+		})
+		if err != nil {
+			if !errors.Is(err, errDeletedCharacterNotFound) {
+				log.Err(err).Msgf("삭제된 캐릭터 완전 삭제 실패: %v - %v", deleted.Uid, deleted.Character.Slot)
+			}
+			continue
 		}
-		return successUidsList
-	}(), nil
+		purged = append(purged, deleted)
+	}
+
+	return purged
+}
+
+func (r *UserMongoRepository) purgeDeletedCharacter(ctx context.Context, deleted *entity.DeletedCharacter, now time.Time) error {
+	result, err := r.deletedCharacter.DeleteOne(ctx, bson.D{
+		{Key: "_id", Value: deleted.Id},
+		{Key: "purge_at", Value: bson.D{
+			{Key: "$lte", Value: now},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		// 다른 서버에서 먼저 완전 삭제했거나 복구된 경우
+		return errDeletedCharacterNotFound
+	}
+
+	// 같은 이름의 캐릭터로 복구된 경우 캐릭터 이름을 해제하지 않습니다
+	count, err := r.user.CountDocuments(ctx, bson.D{
+		{Key: "_id", Value: deleted.Uid},
+		{Key: "characters.name", Value: deleted.Character.Name},
+	})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	return r.releaseCharacterName(ctx, deleted.Uid, deleted.Character.Name)
 }
 
 func (r *UserMongoRepository) FindCharacters(ctx context.Context, uids []string) (map[string][]*entity.Character, error) {
@@ -412,3 +1354,204 @@ func (r *UserMongoRepository) FindCharacters(ctx context.Context, uids []string)
 
 	return charMap, nil
 }
+
+// 유저별로 확장한 캐릭터 슬롯 수를 조회합니다 (확장한 적이 없으면 0이며, 존재하지 않는 유저는 포함되지 않습니다)
+func (r *UserMongoRepository) FindCharacterSlotLimits(ctx context.Context, uids []string) (map[string]int, error) {
+	slotLimits := make(map[string]int, len(uids))
+	if len(uids) == 0 {
+		return slotLimits, nil
+	}
+
+	filter := bson.D{
+		{Key: "_id", Value: bson.D{{Key: "$in", Value: uids}}},
+	}
+
+	opts := options.Find().SetProjection(
+		bson.M{
+			"_id":        1,
+			"slot_limit": 1,
+		},
+	)
+
+	cursor, err := r.user.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var users []*entity.User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+
+	for _, u := range users {
+		slotLimits[u.Uid] = u.SlotLimit
+	}
+
+	return slotLimits, nil
+}
+
+// 확장한 캐릭터 슬롯 수를 current에서 next로 변경합니다. 다른 요청이 먼저 변경했다면 false를 반환합니다
+func (r *UserMongoRepository) UpdateCharacterSlotLimit(ctx context.Context, uid string, current int, next int) (bool, error) {
+	var currentFilter any = current
+	if current == 0 {
+		// 확장한 적이 없는 유저는 slot_limit 필드가 없습니다
+		currentFilter = bson.D{{Key: "$in", Value: bson.A{nil, 0}}}
+	}
+
+	filter := bson.D{
+		{Key: "_id", Value: uid},
+		{Key: "slot_limit", Value: currentFilter},
+	}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "slot_limit", Value: next},
+		}},
+	}
+
+	result, err := r.user.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
+// 캐릭터 장비를 갱신합니다. 조회한 시점의 장비(PrevEquips)와 저장된 장비가 같을 때만 갱신하여
+// 동시에 들어온 다른 장비 변경을 덮어쓰지 않습니다. 실패한 유저는 유저 고유 ID별 오류 코드로 반환합니다
+func (r *UserMongoRepository) UpdateCharacterEquips(ctx context.Context, infos []*UserUpdateCharacterEquips) (map[string]string, error) {
+	failureUids := make(map[string]string)
+	if len(infos) == 0 {
+		return failureUids, nil
+	}
+
+	for _, info := range infos {
+		// 장비가 없는 캐릭터는 equips 필드가 없거나 빈 배열로 저장되어 있습니다
+		var prevEquips any = info.PrevEquips
+		if len(info.PrevEquips) == 0 {
+			prevEquips = bson.D{{Key: "$in", Value: bson.A{nil, bson.A{}}}}
+		}
+
+		filter := bson.D{
+			{Key: "_id", Value: info.Uid},
+			{Key: "characters", Value: bson.D{
+				{Key: "$elemMatch", Value: bson.D{
+					{Key: "slot", Value: info.Slot},
+					{Key: "equips", Value: prevEquips},
+				}},
+			}},
+		}
+		update := bson.D{
+			{Key: "$set", Value: bson.D{
+				{Key: "characters.$.equips", Value: info.Equips},
+			}},
+		}
+
+		result, err := r.user.UpdateOne(ctx, filter, update)
+		if err != nil {
+			log.Err(err).Msgf("캐릭터 장비 갱신 실패: %v - %v", info.Uid, info.Slot)
+			failureUids[info.Uid] = USER_EQUIP_CHARACTER_DB_WRITE_ERROR
+			continue
+		}
+
+		if result.MatchedCount == 0 {
+			// 조회 이후 캐릭터가 삭제되었거나 다른 요청으로 장비가 바뀌었습니다
+			failureUids[info.Uid] = USER_EQUIP_CHARACTER_CHANGED_ERROR
+		}
+	}
+
+	return failureUids, nil
+}
+
+// 유저들의 캐릭터 생성 초안을 조회합니다. TTL 인덱스로 삭제되기 전이라도 만료된 초안은 제외합니다
+func (r *UserMongoRepository) FindCharacterDrafts(ctx context.Context, uids []string) (map[string][]*entity.CharacterDraft, error) {
+	draftMap := make(map[string][]*entity.CharacterDraft, len(uids))
+	if len(uids) == 0 {
+		return draftMap, nil
+	}
+
+	filter := bson.D{
+		{Key: "uid", Value: bson.D{{Key: "$in", Value: uids}}},
+		{Key: "expire_at", Value: bson.D{{Key: "$gt", Value: time.Now().UTC()}}},
+	}
+
+	cursor, err := r.characterDraft.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var drafts []*entity.CharacterDraft
+	if err := cursor.All(ctx, &drafts); err != nil {
+		return nil, err
+	}
+
+	for _, draft := range drafts {
+		draftMap[draft.Uid] = append(draftMap[draft.Uid], draft)
+	}
+
+	return draftMap, nil
+}
+
+// 캐릭터 생성 초안을 저장합니다
+// 조회 이후 다른 요청이 먼저 초안을 저장한 경우 유니크 인덱스 충돌로 실패하며, 실패한 유저 고유 ID 목록을 반환합니다
+func (r *UserMongoRepository) SaveCharacterDrafts(ctx context.Context, drafts []*entity.CharacterDraft, now time.Time) ([]string, error) {
+	if len(drafts) == 0 {
+		return []string{}, nil
+	}
+
+	writeModels := make([]mongo.WriteModel, len(drafts))
+	for i, draft := range drafts {
+		filter := bson.D{
+			{Key: "uid", Value: draft.Uid},
+			{Key: "slot", Value: draft.Slot},
+		}
+
+		if draft.Rerolls == 0 {
+			// 새 초안: 초안이 없거나 만료된 경우에만 저장
+			filter = append(filter, bson.E{Key: "expire_at", Value: bson.D{{Key: "$lte", Value: now}}})
+		} else {
+			// 다시 굴린 초안: 조회한 초안이 그대로인 경우에만 저장
+			filter = append(filter, bson.E{Key: "rerolls", Value: draft.Rerolls - 1})
+		}
+
+		writeModels[i] = mongo.NewReplaceOneModel().SetFilter(filter).SetReplacement(draft).SetUpsert(true)
+	}
+
+	failedUids := make([]string, 0)
+	_, err := r.characterDraft.BulkWrite(ctx, writeModels, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		bulkErr, ok := err.(mongo.BulkWriteException)
+		if !ok {
+			return nil, err
+		}
+
+		for _, writeErr := range bulkErr.WriteErrors {
+			if !mongo.IsDuplicateKeyError(writeErr) {
+				return nil, err
+			}
+			failedUids = append(failedUids, drafts[writeErr.Index].Uid)
+		}
+	}
+
+	return failedUids, nil
+}
+
+// 캐릭터 생성에 사용한 초안을 삭제합니다
+func (r *UserMongoRepository) DeleteCharacterDrafts(ctx context.Context, drafts []*entity.CharacterDraft) error {
+	if len(drafts) == 0 {
+		return nil
+	}
+
+	writeModels := make([]mongo.WriteModel, len(drafts))
+	for i, draft := range drafts {
+		filter := bson.D{
+			{Key: "uid", Value: draft.Uid},
+			{Key: "slot", Value: draft.Slot},
+		}
+		writeModels[i] = mongo.NewDeleteOneModel().SetFilter(filter)
+	}
+
+	_, err := r.characterDraft.BulkWrite(ctx, writeModels, options.BulkWrite().SetOrdered(false))
+	return err
+}
@@ -0,0 +1,183 @@
+package user
+
+import (
+	"MScannot206/shared/entity"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+)
+
+// 일시적인 오류로 트랜잭션이 실패했을 때 다시 시도하는 최대 횟수
+const transactionMaxAttempts = 3
+
+// 트랜잭션을 다시 시도하기 전 대기 시간 (시도 횟수만큼 늘어납니다)
+const transactionRetryBackoff = 20 * time.Millisecond
+
+// 트랜잭션 재시도 판단에 사용하는 오류 라벨
+const (
+	driverErrorLabelTransient     = "TransientTransactionError"
+	driverErrorLabelUnknownCommit = "UnknownTransactionCommitResult"
+)
+
+// 캐릭터를 생성(복구)할 슬롯에 이미 캐릭터가 있거나 삭제할 슬롯에 캐릭터가 없는 경우
+var errCharacterSlotUnavailable = errors.New("character slot is unavailable")
+
+// 복구할 삭제된 캐릭터가 없거나 이미 완전 삭제된 경우
+var errDeletedCharacterNotFound = errors.New("deleted character not found")
+
+// 멀티 도큐먼트 트랜잭션을 사용할 수 있는 배포 형태인지 확인합니다 (레플리카 셋, 샤드 클러스터)
+func supportsTransaction(ctx context.Context, client *mongo.Client) bool {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+
+	err := client.Database("admin").RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello)
+	if err != nil {
+		log.Warn().Err(err).Msg("몽고DB 배포 형태 확인 실패")
+		return false
+	}
+
+	return hello.SetName != "" || hello.Msg == "isdbgrid"
+}
+
+// fn을 하나의 트랜잭션으로 실행합니다
+// 일시적인 오류(TransientTransactionError)는 transactionMaxAttempts번까지 처음부터 다시 시도하고,
+// 커밋 결과를 알 수 없는 경우(UnknownTransactionCommitResult)는 커밋만 다시 시도합니다
+func (r *UserMongoRepository) runTransaction(ctx context.Context, fn func(sc mongo.SessionContext) error) error {
+	session, err := r.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	txnOpts := options.Transaction().
+		SetWriteConcern(writeconcern.Majority()).
+		SetReadConcern(readconcern.Snapshot())
+
+	for attempt := 1; ; attempt++ {
+		err = mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+			if err := session.StartTransaction(txnOpts); err != nil {
+				return err
+			}
+
+			if err := fn(sc); err != nil {
+				_ = session.AbortTransaction(sc)
+				return err
+			}
+
+			return commitTransaction(sc, session)
+		})
+
+		if err == nil || !hasErrorLabel(err, driverErrorLabelTransient) || attempt >= transactionMaxAttempts {
+			return err
+		}
+
+		log.Warn().Err(err).Msgf("트랜잭션 재시도: %d/%d", attempt, transactionMaxAttempts)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt) * transactionRetryBackoff):
+		}
+	}
+}
+
+// fn을 트랜잭션을 사용할 수 있으면 하나의 트랜잭션으로, 아니면 그대로 실행합니다
+// 트랜잭션 없이 실행하는 경우 fn은 중간에 실패해도 작업 기록이나 보상 처리로 복구할 수 있어야 합니다
+func (r *UserMongoRepository) runAtomic(ctx context.Context, fn func(ctx context.Context) error) error {
+	if !r.transactional {
+		return fn(ctx)
+	}
+
+	return r.runTransaction(ctx, func(sc mongo.SessionContext) error {
+		return fn(sc)
+	})
+}
+
+func commitTransaction(sc mongo.SessionContext, session mongo.Session) error {
+	for attempt := 1; ; attempt++ {
+		err := session.CommitTransaction(sc)
+		if err == nil || !hasErrorLabel(err, driverErrorLabelUnknownCommit) || attempt >= transactionMaxAttempts {
+			return err
+		}
+	}
+}
+
+func hasErrorLabel(err error, label string) bool {
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) {
+		return serverErr.HasErrorLabel(label)
+	}
+	return false
+}
+
+// 유저별 트랜잭션으로 캐릭터 이름과 캐릭터를 함께 저장합니다
+// 한 유저의 실패는 해당 유저의 오류 코드로만 반환하고 나머지 유저는 계속 처리합니다
+func (r *UserMongoRepository) createCharactersInTransaction(ctx context.Context, infos []*UserCreateCharacter) (map[string]*entity.Character, map[string]string, error) {
+	createdCharacters := make(map[string]*entity.Character, len(infos))
+	failureUids := make(map[string]string)
+
+	for _, info := range infos {
+		newCharacter := newCharacterByCreateInfo(info)
+
+		err := r.runTransaction(ctx, func(sc mongo.SessionContext) error {
+			now := time.Now().UTC()
+
+			_, err := r.characterName.UpdateOne(
+				sc,
+				characterNameClaimFilter(info.Uid, info.Name, now),
+				characterNameClaimUpdate(info.Uid, info.Name, now),
+				options.Update().SetUpsert(true),
+			)
+			if err != nil {
+				return err
+			}
+
+			filter := bson.D{
+				{Key: "_id", Value: info.Uid},
+				{Key: "characters.slot", Value: bson.D{
+					{Key: "$ne", Value: info.Slot},
+				}},
+			}
+			update := bson.D{
+				{Key: "$push", Value: bson.D{
+					{Key: "characters", Value: newCharacter},
+				}},
+			}
+
+			result, err := r.user.UpdateOne(sc, filter, update)
+			if err != nil {
+				return err
+			}
+			if result.MatchedCount == 0 {
+				return errCharacterSlotUnavailable
+			}
+			return nil
+		})
+
+		switch {
+		case err == nil:
+			createdCharacters[info.Uid] = newCharacter
+		case mongo.IsDuplicateKeyError(err):
+			failureUids[info.Uid] = USER_CHARACTER_NAME_ALREADY_EXISTS_ERROR
+		case errors.Is(err, errCharacterSlotUnavailable):
+			failureUids[info.Uid] = USER_CHARACTER_SLOT_ALREADY_EXISTS_ERROR
+		default:
+			log.Err(err).Msgf("캐릭터 생성 트랜잭션 실패: %v", info.Uid)
+			failureUids[info.Uid] = USER_CREATE_CHARACTER_DB_WRITE_ERROR
+		}
+	}
+
+	return createdCharacters, failureUids, nil
+}
@@ -0,0 +1,162 @@
+package user
+
+import (
+	"MScannot206/shared/def"
+	"MScannot206/shared/table"
+	"MScannot206/shared/types"
+	"MScannot206/shared/util"
+	"strings"
+	"unicode"
+)
+
+// 캐릭터 이름 규칙
+// 이름이 규칙을 위반하면 규칙의 오류 코드를, 통과하면 빈 문자열을 반환합니다
+type CharacterNameRule interface {
+	Check(name string) string
+}
+
+// 캐릭터 이름 정책은 로케일별 캐릭터 이름 규칙 목록입니다
+// 규칙은 추가한 순서대로 검사하며 처음 위반한 규칙의 오류 코드를 반환합니다
+type CharacterNamePolicy struct {
+	rules []CharacterNameRule
+}
+
+func NewCharacterNamePolicy(rules ...CharacterNameRule) *CharacterNamePolicy {
+	return &CharacterNamePolicy{rules: rules}
+}
+
+// 캐릭터 이름을 정책의 모든 규칙으로 검사합니다
+func (p *CharacterNamePolicy) Validate(name string) string {
+	for _, rule := range p.rules {
+		if errCode := rule.Check(name); errCode != "" {
+			return errCode
+		}
+	}
+	return ""
+}
+
+// 한글 완성형 음절 (가~힣)
+var hangulSyllables = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0xAC00, Hi: 0xD7A3, Stride: 1},
+	},
+}
+
+// 영문 알파벳과 숫자
+var asciiAlphanumeric = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: '0', Hi: '9', Stride: 1},
+		{Lo: 'A', Hi: 'Z', Stride: 1},
+		{Lo: 'a', Hi: 'z', Stride: 1},
+	},
+	LatinOffset: 3,
+}
+
+// 가타카나 장음 기호 (ー)
+var katakanaProlongedSoundMark = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x30FC, Hi: 0x30FC, Stride: 1},
+	},
+}
+
+// 로케일별 캐릭터 이름에 사용할 수 있는 문자
+var characterNameScripts = map[def.Locale][]*unicode.RangeTable{
+	def.LocaleKorean:   {hangulSyllables, asciiAlphanumeric},
+	def.LocaleEnglish:  {asciiAlphanumeric},
+	def.LocaleJapanese: {unicode.Hiragana, unicode.Katakana, katakanaProlongedSoundMark, unicode.Han, asciiAlphanumeric},
+}
+
+// 캐릭터 이름에 로케일에서 허용하지 않는 문자가 있는지 검사합니다
+type characterNameScriptRule struct {
+	scripts []*unicode.RangeTable
+}
+
+func (r *characterNameScriptRule) Check(name string) string {
+	for _, c := range name {
+		if !unicode.In(c, r.scripts...) {
+			return USER_CREATE_CHARACTER_NAME_SPECIAL_CHAR_ERROR
+		}
+	}
+	return ""
+}
+
+// 캐릭터 이름의 표시 폭을 검사합니다 (한글, 한자, 가나는 2칸)
+type characterNameWidthRule struct {
+	min int
+	max int
+}
+
+func (r *characterNameWidthRule) Check(name string) string {
+	w := util.DisplayWidth(name)
+	if w < r.min {
+		return USER_CREATE_CHARACTER_NAME_MIN_LENGTH_ERROR
+	} else if w > r.max {
+		return USER_CREATE_CHARACTER_NAME_MAX_LENGTH_ERROR
+	}
+	return ""
+}
+
+// 캐릭터 이름에 금지어가 포함되어 있는지 검사합니다
+// 이름과 금지어는 모두 util.FoldConfusable로 정규화한 뒤 비교합니다
+type characterNameBannedWordRule struct {
+	words []string
+}
+
+func (r *characterNameBannedWordRule) Check(name string) string {
+	folded := util.FoldConfusable(name)
+	for _, word := range r.words {
+		if strings.Contains(folded, word) {
+			return USER_CREATE_CHARACTER_NAME_BANNED_WORD_ERROR
+		}
+	}
+	return ""
+}
+
+// 캐릭터 이름이 예약된 이름(운영자 이름 등)인지 검사합니다
+// 이름과 예약어는 모두 util.FoldConfusable로 정규화한 뒤 비교합니다
+type characterNameReservedRule struct {
+	names map[string]struct{}
+}
+
+func (r *characterNameReservedRule) Check(name string) string {
+	if _, ok := r.names[util.FoldConfusable(name)]; ok {
+		return USER_CREATE_CHARACTER_NAME_RESERVED_ERROR
+	}
+	return ""
+}
+
+// 이름 필터 테이블로 로케일별 캐릭터 이름 정책을 생성합니다
+// 이름 필터의 Locale이 비어 있으면 모든 로케일에 적용합니다
+func newCharacterNamePolicies(filters *table.NameFilterTable) map[def.Locale]*CharacterNamePolicy {
+	policies := make(map[def.Locale]*CharacterNamePolicy, len(characterNameScripts))
+	for locale, scripts := range characterNameScripts {
+		bannedWordRule := &characterNameBannedWordRule{}
+		reservedRule := &characterNameReservedRule{names: make(map[string]struct{})}
+
+		for rec := range filters.All() {
+			if rec.Locale != "" && def.Locale(rec.Locale) != locale {
+				continue
+			}
+
+			word := util.FoldConfusable(rec.Word)
+			if word == "" {
+				continue
+			}
+
+			switch rec.FilterType {
+			case types.NameFilterType_Banned:
+				bannedWordRule.words = append(bannedWordRule.words, word)
+			case types.NameFilterType_Reserved:
+				reservedRule.names[word] = struct{}{}
+			}
+		}
+
+		policies[locale] = NewCharacterNamePolicy(
+			&characterNameScriptRule{scripts: scripts},
+			&characterNameWidthRule{min: def.MinCharacterNameWidth, max: def.MaxCharacterNameWidth},
+			reservedRule,
+			bannedWordRule,
+		)
+	}
+	return policies
+}
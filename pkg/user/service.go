@@ -1,38 +1,158 @@
 package user
 
 import (
+	"MScannot206/shared/def"
 	"MScannot206/shared/entity"
 	"MScannot206/shared/table"
-	"MScannot206/shared/table/view"
 	"MScannot206/shared/types"
 	"context"
 	"errors"
+	"maps"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
 )
 
-func NewUserService(tableRepo *table.Repository) (*UserService, error) {
-	return &UserService{}, nil
+// 캐릭터 이름 예약 유지 시간
+const characterNameReserveTTL = 5 * time.Minute
+
+// 캐릭터가 없는 캐릭터 이름 정리 주기
+const characterNameJanitorInterval = 10 * time.Minute
+
+// 캐릭터 이름 정리 시 생성 중일 수 있으므로 검사하지 않는 최근 사용 시간
+const characterNameOrphanGrace = 10 * time.Minute
+
+// 캐릭터 이름 정리 시 한 번에 검사하는 이름 개수
+const characterNameJanitorBatchSize = 100
+
+// 남은 캐릭터 작업 기록 복구 주기
+const characterOutboxInterval = time.Minute
+
+// 캐릭터 작업 기록 복구 시 진행 중일 수 있으므로 복구하지 않는 최근 작업 시간
+const characterOutboxGrace = time.Minute
+
+// 캐릭터 작업 기록 복구 시 한 번에 복구하는 작업 기록 개수
+const characterOutboxBatchSize = 100
+
+// 삭제된 캐릭터를 복구할 수 있는 기본 유예 시간(시간)
+const DefaultCharacterDeleteGraceHours = 72
+
+// 유예 기간이 지난 삭제된 캐릭터 완전 삭제 주기
+const deletedCharacterPurgeInterval = 5 * time.Minute
+
+// 삭제된 캐릭터 완전 삭제 시 한 번에 처리하는 캐릭터 개수
+const deletedCharacterPurgeBatchSize = 100
+
+// 캐릭터 이름을 다시 변경할 수 있는 기본 대기 시간(시간)
+const DefaultCharacterRenameCooldownHours = 168
+
+// 캐릭터 생성 초안 유지 시간
+const characterDraftTTL = 10 * time.Minute
+
+// 캐릭터 생성 미리보기를 다시 굴릴 수 있는 최대 횟수
+const MaxCharacterDraftRerolls = 5
+
+// 유저 서비스 설정 (0이나 빈 값은 기본값을 사용합니다)
+type UserServiceConfig struct {
+	// 삭제된 캐릭터를 복구할 수 있는 유예 시간(시간)
+	CharacterDeleteGraceHours int
+
+	// 캐릭터 이름을 다시 변경할 수 있는 대기 시간(시간)
+	CharacterRenameCooldownHours int
+
+	// 유저 캐릭터 슬롯 수 기본값
+	CharacterSlotDefault int
+
+	// 캐릭터 슬롯 확장 시 소모하는 아이템 인덱스 (비어 있으면 확장할 수 없습니다)
+	CharacterSlotExpandItem string
+
+	// 캐릭터 슬롯 확장 시 소모하는 아이템 개수
+	CharacterSlotExpandItemCount int64
+}
+
+func NewUserService(tableRepo *table.Repository, cfg UserServiceConfig) (*UserService, error) {
+	if cfg.CharacterDeleteGraceHours <= 0 {
+		cfg.CharacterDeleteGraceHours = DefaultCharacterDeleteGraceHours
+	}
+
+	if cfg.CharacterRenameCooldownHours <= 0 {
+		cfg.CharacterRenameCooldownHours = DefaultCharacterRenameCooldownHours
+	}
+
+	if cfg.CharacterSlotDefault <= 0 {
+		cfg.CharacterSlotDefault = def.DefaultCharacterSlot
+	}
+
+	if cfg.CharacterSlotExpandItemCount <= 0 {
+		cfg.CharacterSlotExpandItemCount = 1
+	}
+
+	return &UserService{
+		characterDeleteGrace:         time.Duration(cfg.CharacterDeleteGraceHours) * time.Hour,
+		characterRenameCooldown:      time.Duration(cfg.CharacterRenameCooldownHours) * time.Hour,
+		characterSlotDefault:         min(cfg.CharacterSlotDefault, def.MaxCharacterSlot),
+		characterSlotExpandItem:      cfg.CharacterSlotExpandItem,
+		characterSlotExpandItemCount: cfg.CharacterSlotExpandItemCount,
+	}, nil
 }
 
 // 유저 서비스는 유저 관리 및 유저에 종속된 데이터를 관리하는 서비스입니다
 type UserService struct {
-	// 캐릭터 생성 테이블 뷰
-	createCharacterView view.CreateCharacterView
+	// 테이블 스냅샷 (테이블 리로드 시 통째로 교체됩니다)
+	tables atomic.Pointer[userTables]
 
 	// 랜덤 서비스 핸들러
 	randomServiceHandler RandomServiceHandler
 
-	// 테이블 레포지토리
-	tableRepo *table.Repository
+	// 인벤토리 서비스 핸들러
+	inventoryServiceHandler InventoryServiceHandler
 
 	// 유저 DB 레포지토리
 	userRepo *UserMongoRepository
+
+	// 삭제된 캐릭터를 복구할 수 있는 유예 기간
+	characterDeleteGrace time.Duration
+
+	// 캐릭터 이름을 다시 변경할 수 있는 대기 시간
+	characterRenameCooldown time.Duration
+
+	// 유저 캐릭터 슬롯 수 기본값
+	characterSlotDefault int
+
+	// 캐릭터 슬롯 확장 비용
+	characterSlotExpandItem      string
+	characterSlotExpandItemCount int64
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
 }
 
 func (s *UserService) Start(ctx context.Context) error {
+	janitorCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+
+	s.wg.Go(func() {
+		s.characterNameJanitor(janitorCtx)
+	})
+
+	s.wg.Go(func() {
+		s.characterOutboxWorker(janitorCtx)
+	})
+
+	s.wg.Go(func() {
+		s.deletedCharacterPurger(janitorCtx)
+	})
+
 	return nil
 }
 
 func (s *UserService) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
 	return nil
 }
 
@@ -47,32 +167,10 @@ func (s *UserService) SetRepositories(
 		errs = errors.Join(errs, ErrUserMongoRepositoryIsNil)
 	}
 
-	s.tableRepo = tableRepo
 	if tableRepo == nil {
 		errs = errors.Join(errs, table.ErrTableRepositoryIsNil)
-	} else { // 테이블 뷰 생성
-
-		// 캐릭터 생성 테이블 뷰 생성
-		s.createCharacterView = view.NewCreateCharacterView(
-			tableRepo.CreateCharacter,
-			tableRepo.CreateCharacterHair,
-			tableRepo.CreateCharacterFace,
-			tableRepo.CreateCharacterCap,
-			tableRepo.CreateCharacterCape,
-			tableRepo.CreateCharacterCoat,
-			tableRepo.CreateCharacterGlove,
-			tableRepo.CreateCharacterLongCoat,
-			tableRepo.CreateCharacterPants,
-			tableRepo.CreateCharacterShoes,
-			tableRepo.CreateCharacterFaceAcc,
-			tableRepo.CreateCharacterEysAcc,
-			tableRepo.CreateCharacterEarAcc,
-			tableRepo.CreateCharacter1HWeapon,
-			tableRepo.CreateCharacter2HWeapon,
-			tableRepo.CreateCharacterSubWeapon,
-			tableRepo.CreateCharacterEar,
-			tableRepo.CreateCharacterSkin,
-		)
+	} else if err := s.OnTableReload(tableRepo); err != nil {
+		errs = errors.Join(errs, err)
 	}
 
 	return errs
@@ -80,6 +178,7 @@ func (s *UserService) SetRepositories(
 
 func (s *UserService) SetHandlers(
 	randomServiceHandler RandomServiceHandler,
+	inventoryServiceHandler InventoryServiceHandler,
 ) error {
 	var errs error
 
@@ -88,9 +187,25 @@ func (s *UserService) SetHandlers(
 		errs = errors.Join(errs, ErrRandomServiceHandlerIsNil)
 	}
 
+	s.inventoryServiceHandler = inventoryServiceHandler
+	if inventoryServiceHandler == nil {
+		errs = errors.Join(errs, ErrInventoryServiceHandlerIsNil)
+	}
+
 	return errs
 }
 
+// 새로 불러온 테이블 레포지토리로 테이블 뷰를 다시 생성하여 교체합니다
+// 테이블 뷰 생성에 실패하면 기존 테이블 뷰를 그대로 사용합니다
+func (s *UserService) OnTableReload(tableRepo *table.Repository) error {
+	tables, err := newUserTables(tableRepo)
+	if err != nil {
+		return err
+	}
+	s.tables.Store(tables)
+	return nil
+}
+
 func (s *UserService) FindCharactersByUids(ctx context.Context, uids []string) (map[string][]*entity.Character, error) {
 	if len(uids) == 0 {
 		return map[string][]*entity.Character{}, nil
@@ -102,6 +217,56 @@ func (s *UserService) FindCharacterNames(ctx context.Context, names []string) (m
 	return s.userRepo.ExistsCharacterNames(ctx, names)
 }
 
+// 캐릭터 이름을 사용할 수 있는지 검사하고, 요청한 경우 사용 가능한 이름을 유저에게 예약합니다
+// 유저 자신이 예약한 이름은 사용 가능한 이름으로 판단합니다
+func (s *UserService) CheckCharacterNames(ctx context.Context, checkInfos []*UserCheckCharacterName) (map[string]UserCheckCharacterNameResult, error) {
+	ret := make(map[string]UserCheckCharacterNameResult, len(checkInfos))
+	if len(checkInfos) == 0 {
+		return ret, nil
+	}
+
+	names := make([]string, 0, len(checkInfos))
+	for _, info := range checkInfos {
+		names = append(names, info.Name)
+	}
+
+	charNames, err := s.userRepo.FindCharacterNames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	reserveInfos := make([]*UserCheckCharacterName, 0, len(checkInfos))
+	for _, info := range checkInfos {
+		if charName, ok := charNames[info.Name]; ok && (charName.Uid != info.Uid || charName.ExpireAt == nil) {
+			ret[info.Uid] = UserCheckCharacterNameResult{ErrorCode: USER_CHARACTER_NAME_ALREADY_EXISTS_ERROR}
+			continue
+		}
+
+		ret[info.Uid] = UserCheckCharacterNameResult{}
+		if info.Reserve {
+			reserveInfos = append(reserveInfos, info)
+		}
+	}
+
+	expireAt := time.Now().UTC().Add(characterNameReserveTTL)
+	failedUids, err := s.userRepo.ReserveCharacterNames(ctx, reserveInfos, expireAt)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range reserveInfos {
+		ret[info.Uid] = UserCheckCharacterNameResult{ReservedUntil: expireAt}
+	}
+
+	// 검사 이후 다른 유저가 먼저 사용하거나 예약한 이름
+	for _, uid := range failedUids {
+		ret[uid] = UserCheckCharacterNameResult{ErrorCode: USER_CHARACTER_NAME_ALREADY_EXISTS_ERROR}
+	}
+
+	return ret, nil
+}
+
+// 미리보기 초안이 있으면 초안의 장비로, 없으면 랜덤으로 장비를 결정하여 캐릭터를 생성합니다
 func (s *UserService) CreateCharacterByUsers(ctx context.Context, createInfos []*UserCreateCharacter) (map[string]UserCreateCharacterResult, error) {
 	if len(createInfos) == 0 {
 		return map[string]UserCreateCharacterResult{}, nil
@@ -111,20 +276,56 @@ func (s *UserService) CreateCharacterByUsers(ctx context.Context, createInfos []
 		return map[string]UserCreateCharacterResult{}, ErrRandomServiceHandlerIsNil
 	}
 
+	if s.inventoryServiceHandler == nil {
+		return map[string]UserCreateCharacterResult{}, ErrInventoryServiceHandlerIsNil
+	}
+
+	uids := make([]string, 0, len(createInfos))
+	for _, info := range createInfos {
+		uids = append(uids, info.Uid)
+	}
+
+	drafts, err := s.userRepo.FindCharacterDrafts(ctx, uids)
+	if err != nil {
+		return map[string]UserCreateCharacterResult{}, err
+	}
+
+	deletedCharacters, err := s.userRepo.FindSlotDeletedCharacters(ctx, uids)
+	if err != nil {
+		return map[string]UserCreateCharacterResult{}, err
+	}
+
+	tables := s.tables.Load()
 	ret := make(map[string]UserCreateCharacterResult, len(createInfos))
 	params := make([]*UserCreateCharacter, 0, len(createInfos))
+	usedDrafts := make(map[string]*entity.CharacterDraft, len(createInfos))
 	for _, info := range createInfos {
 		result := UserCreateCharacterResult{}
-		switch info.Gender {
-		case types.GenderType_Male:
-			result.Equips = s.createCharacterView.GetMale(s.randomServiceHandler.GetCharacterCreateSeed())
-		case types.GenderType_Female:
-			result.Equips = s.createCharacterView.GetFemale(s.randomServiceHandler.GetCharacterCreateSeed())
+
+		draft := findCharacterDraftBySlot(drafts[info.Uid], info.Slot)
+		switch {
+		case findDeletedCharacterBySlot(deletedCharacters[info.Uid], info.Slot) != nil:
+			// 삭제된 캐릭터는 완전 삭제 전까지 슬롯을 차지합니다
+			result.ErrorCode = USER_CHARACTER_SLOT_DELETE_PENDING_ERROR
+		case draft == nil:
+			equips, errCode, err := s.rollCharacterEquips(ctx, tables, info.Uid, info.Gender, info.Appearance)
+			if err != nil {
+				return map[string]UserCreateCharacterResult{}, err
+			}
+			result.Equips = equips
+			result.ErrorCode = errCode
+		case draft.Gender != info.Gender:
+			result.ErrorCode = USER_CREATE_CHARACTER_DRAFT_GENDER_MISMATCH_ERROR
 		default:
-			result.ErrorCode = USER_CREATE_CHARACTER_GENDER_INVALID_ERROR
+			// 미리보기로 보여준 장비를 그대로 사용
+			result.Equips = entity.NewCharacterEquipMap(draft.Equips)
+			usedDrafts[info.Uid] = draft
 		}
+
 		ret[info.Uid] = result
 		if result.ErrorCode == "" {
+			// 생성 시 결정된 장비를 캐릭터와 함께 저장
+			info.Equips = entity.NewCharacterEquips(result.Equips)
 			params = append(params, info)
 		}
 	}
@@ -134,11 +335,25 @@ func (s *UserService) CreateCharacterByUsers(ctx context.Context, createInfos []
 		return map[string]UserCreateCharacterResult{}, err
 	}
 
+	consumedDrafts := make([]*entity.CharacterDraft, 0, len(usedDrafts))
 	for uid, character := range createdCharacters {
 		if result, ok := ret[uid]; ok {
 			result.Character = character
 			ret[uid] = result
 		}
+		if draft, ok := usedDrafts[uid]; ok {
+			consumedDrafts = append(consumedDrafts, draft)
+		}
+	}
+
+	// 생성 장비는 소유한 아이템으로 캐릭터 인벤토리에 지급하여 다른 장비로 바꾼 뒤에도 다시 장착할 수 있게 합니다
+	if err := s.inventoryServiceHandler.AddCharacterEquipItems(ctx, createdCharacters); err != nil {
+		log.Err(err).Msg("캐릭터 생성 장비 지급 중 오류 발생")
+	}
+
+	// 캐릭터 생성에 사용한 초안 삭제 (실패하더라도 TTL 인덱스로 삭제됩니다)
+	if err := s.userRepo.DeleteCharacterDrafts(ctx, consumedDrafts); err != nil {
+		log.Err(err).Msg("캐릭터 생성 초안 삭제 중 오류 발생")
 	}
 
 	for uid, failureCode := range failureUids {
@@ -151,9 +366,627 @@ func (s *UserService) CreateCharacterByUsers(ctx context.Context, createInfos []
 	return ret, nil
 }
 
-func (s *UserService) DeleteCharactersByUsers(ctx context.Context, deleteInfos []*UserDeleteCharacter) ([]string, error) {
+// 캐릭터 생성 장비를 미리 굴려 초안으로 저장합니다. 초안이 있으면 다시 굴리며, 다시 굴린 횟수가 제한을 넘으면 실패합니다
+func (s *UserService) PreviewCharacterByUsers(ctx context.Context, previewInfos []*UserPreviewCharacter) (map[string]UserPreviewCharacterResult, error) {
+	if len(previewInfos) == 0 {
+		return map[string]UserPreviewCharacterResult{}, nil
+	}
+
+	if s.randomServiceHandler == nil {
+		return map[string]UserPreviewCharacterResult{}, ErrRandomServiceHandlerIsNil
+	}
+
+	uids := make([]string, 0, len(previewInfos))
+	for _, info := range previewInfos {
+		uids = append(uids, info.Uid)
+	}
+
+	drafts, err := s.userRepo.FindCharacterDrafts(ctx, uids)
+	if err != nil {
+		return map[string]UserPreviewCharacterResult{}, err
+	}
+
+	tables := s.tables.Load()
+	now := time.Now().UTC()
+	ret := make(map[string]UserPreviewCharacterResult, len(previewInfos))
+	saveDrafts := make([]*entity.CharacterDraft, 0, len(previewInfos))
+	for _, info := range previewInfos {
+		result := UserPreviewCharacterResult{}
+
+		rerolls := 0
+		if draft := findCharacterDraftBySlot(drafts[info.Uid], info.Slot); draft != nil {
+			if draft.Rerolls >= MaxCharacterDraftRerolls {
+				// 기존 초안은 만료 전까지 그대로 캐릭터 생성에 사용할 수 있습니다
+				result.Equips = entity.NewCharacterEquipMap(draft.Equips)
+				result.ExpireAt = draft.ExpireAt
+				result.ErrorCode = USER_PREVIEW_CHARACTER_REROLL_LIMIT_ERROR
+				ret[info.Uid] = result
+				continue
+			}
+			rerolls = draft.Rerolls + 1
+		}
+
+		equips, errCode, err := s.rollCharacterEquips(ctx, tables, info.Uid, info.Gender, info.Appearance)
+		if err != nil {
+			return map[string]UserPreviewCharacterResult{}, err
+		}
+
+		if errCode != "" {
+			result.ErrorCode = errCode
+			ret[info.Uid] = result
+			continue
+		}
+
+		draft := &entity.CharacterDraft{
+			Uid:      info.Uid,
+			Slot:     info.Slot,
+			Gender:   info.Gender,
+			Equips:   entity.NewCharacterEquips(equips),
+			Rerolls:  rerolls,
+			ExpireAt: now.Add(characterDraftTTL),
+		}
+		saveDrafts = append(saveDrafts, draft)
+
+		result.Equips = equips
+		result.RerollsLeft = MaxCharacterDraftRerolls - rerolls
+		result.ExpireAt = draft.ExpireAt
+		ret[info.Uid] = result
+	}
+
+	failedUids, err := s.userRepo.SaveCharacterDrafts(ctx, saveDrafts, now)
+	if err != nil {
+		return map[string]UserPreviewCharacterResult{}, err
+	}
+
+	for _, uid := range failedUids {
+		ret[uid] = UserPreviewCharacterResult{
+			ErrorCode: USER_PREVIEW_CHARACTER_ALREADY_REQUEST,
+		}
+	}
+
+	return ret, nil
+}
+
+// 캐릭터 생성 장비를 랜덤으로 결정하고 직접 선택한 외형을 적용합니다
+func (s *UserService) rollCharacterEquips(
+	ctx context.Context,
+	tables *userTables,
+	uid string,
+	gender int,
+	appearance map[types.CharacterEquipType]string,
+) (map[types.CharacterEquipType]string, string, error) {
+	if gender != types.GenderType_Male && gender != types.GenderType_Female {
+		return nil, USER_CREATE_CHARACTER_GENDER_INVALID_ERROR, nil
+	}
+
+	if errCode := tables.validateAppearance(gender, appearance); errCode != "" {
+		return nil, errCode, nil
+	}
+
+	rng, err := s.randomServiceHandler.NewCharacterCreateRand(ctx, uid)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var equips map[types.CharacterEquipType]string
+	if gender == types.GenderType_Male {
+		equips = tables.createCharacterView.GetMale(rng)
+	} else {
+		equips = tables.createCharacterView.GetFemale(rng)
+	}
+
+	// 직접 선택한 외형은 랜덤으로 결정된 장비 대신 사용
+	maps.Copy(equips, appearance)
+
+	return equips, "", nil
+}
+
+// 캐릭터를 삭제된 캐릭터로 옮깁니다. 유예 기간 동안 복구할 수 있으며, 캐릭터 이름과 슬롯, 인벤토리는 완전 삭제 시 정리됩니다
+func (s *UserService) DeleteCharactersByUsers(ctx context.Context, deleteInfos []*UserDeleteCharacter) (map[string]UserDeleteCharacterResult, error) {
 	if len(deleteInfos) == 0 {
-		return []string{}, nil
+		return map[string]UserDeleteCharacterResult{}, nil
+	}
+
+	purgeAt := time.Now().UTC().Add(s.characterDeleteGrace)
+	deletedCharacters, failureUids, err := s.userRepo.DeleteCharacters(ctx, deleteInfos, purgeAt)
+	if err != nil {
+		return map[string]UserDeleteCharacterResult{}, err
+	}
+
+	ret := make(map[string]UserDeleteCharacterResult, len(deleteInfos))
+	for uid, deleted := range deletedCharacters {
+		ret[uid] = UserDeleteCharacterResult{PurgeAt: deleted.PurgeAt}
+	}
+
+	for uid, failureCode := range failureUids {
+		ret[uid] = UserDeleteCharacterResult{ErrorCode: failureCode}
+	}
+
+	return ret, nil
+}
+
+// 완전 삭제 전인 삭제된 캐릭터를 원래 슬롯으로 복구합니다
+func (s *UserService) RestoreCharactersByUsers(ctx context.Context, restoreInfos []*UserRestoreCharacter) (map[string]UserRestoreCharacterResult, error) {
+	if len(restoreInfos) == 0 {
+		return map[string]UserRestoreCharacterResult{}, nil
+	}
+
+	restoredCharacters, failureUids, err := s.userRepo.RestoreCharacters(ctx, restoreInfos)
+	if err != nil {
+		return map[string]UserRestoreCharacterResult{}, err
+	}
+
+	ret := make(map[string]UserRestoreCharacterResult, len(restoreInfos))
+	for uid, character := range restoredCharacters {
+		ret[uid] = UserRestoreCharacterResult{Character: character}
+	}
+
+	for uid, failureCode := range failureUids {
+		ret[uid] = UserRestoreCharacterResult{ErrorCode: failureCode}
+	}
+
+	return ret, nil
+}
+
+// 완전 삭제 전인 유저별 삭제된 캐릭터 목록을 조회합니다
+func (s *UserService) FindDeletedCharactersByUids(ctx context.Context, uids []string) (map[string][]*entity.DeletedCharacter, error) {
+	if len(uids) == 0 {
+		return map[string][]*entity.DeletedCharacter{}, nil
+	}
+	return s.userRepo.FindDeletedCharacters(ctx, uids)
+}
+
+// 캐릭터 이름을 변경합니다. 마지막 변경 이후 대기 시간이 지나야 다시 변경할 수 있습니다
+func (s *UserService) RenameCharactersByUsers(ctx context.Context, renameInfos []*UserRenameCharacter) (map[string]UserRenameCharacterResult, error) {
+	if len(renameInfos) == 0 {
+		return map[string]UserRenameCharacterResult{}, nil
+	}
+
+	userCharacters, err := s.FindCharactersByUids(ctx, func() []string {
+		uids := make([]string, 0, len(renameInfos))
+		for _, info := range renameInfos {
+			uids = append(uids, info.Uid)
+		}
+		return uids
+	}())
+	if err != nil {
+		return map[string]UserRenameCharacterResult{}, err
+	}
+
+	now := time.Now().UTC()
+	ret := make(map[string]UserRenameCharacterResult, len(renameInfos))
+	params := make([]*UserRenameCharacter, 0, len(renameInfos))
+	renamedCharacters := make(map[string]*entity.Character, len(renameInfos))
+	for _, info := range renameInfos {
+		character := findCharacterBySlot(userCharacters[info.Uid], info.Slot)
+		if character == nil {
+			ret[info.Uid] = UserRenameCharacterResult{ErrorCode: USER_RENAME_CHARACTER_SLOT_NOT_FOUND_ERROR}
+			continue
+		}
+
+		if character.Name == info.Name {
+			ret[info.Uid] = UserRenameCharacterResult{ErrorCode: USER_RENAME_CHARACTER_SAME_NAME_ERROR}
+			continue
+		}
+
+		if renamedAt, ok := character.LastRenamedAt(); ok {
+			if nextRenameAt := renamedAt.Add(s.characterRenameCooldown); now.Before(nextRenameAt) {
+				ret[info.Uid] = UserRenameCharacterResult{
+					NextRenameAt: nextRenameAt,
+					ErrorCode:    USER_RENAME_CHARACTER_COOLDOWN_ERROR,
+				}
+				continue
+			}
+		}
+
+		info.OldName = character.Name
+		params = append(params, info)
+
+		character.NameHistory = append(character.NameHistory, &entity.CharacterNameHistory{
+			Name:      character.Name,
+			ChangedAt: now,
+		})
+		character.Name = info.Name
+		renamedCharacters[info.Uid] = character
+	}
+
+	failureUids, err := s.userRepo.RenameCharacters(ctx, params, now)
+	if err != nil {
+		return map[string]UserRenameCharacterResult{}, err
+	}
+
+	for _, info := range params {
+		if failureCode, ok := failureUids[info.Uid]; ok {
+			ret[info.Uid] = UserRenameCharacterResult{ErrorCode: failureCode}
+			continue
+		}
+
+		ret[info.Uid] = UserRenameCharacterResult{
+			Character:    renamedCharacters[info.Uid],
+			NextRenameAt: now.Add(s.characterRenameCooldown),
+		}
+	}
+
+	return ret, nil
+}
+
+// 캐릭터 이름이 로케일의 캐릭터 이름 정책(사용 가능 문자, 표시 폭, 예약어, 금지어)을 만족하는지 검사합니다
+func (s *UserService) ValidateCharacterName(name string, locale def.Locale) string {
+	return s.tables.Load().validateCharacterName(name, locale)
+}
+
+// 유저별 캐릭터 슬롯 수를 조회합니다. 확장한 적이 없는 유저는 기본값을 사용하며, 존재하지 않는 유저는 포함되지 않습니다
+func (s *UserService) FindCharacterSlotLimits(ctx context.Context, uids []string) (map[string]int, error) {
+	if len(uids) == 0 {
+		return map[string]int{}, nil
+	}
+
+	slotLimits, err := s.userRepo.FindCharacterSlotLimits(ctx, uids)
+	if err != nil {
+		return nil, err
+	}
+
+	for uid, slotLimit := range slotLimits {
+		slotLimits[uid] = s.characterSlotLimit(slotLimit)
+	}
+
+	return slotLimits, nil
+}
+
+// 저장된 캐릭터 슬롯 수에 기본값을 적용합니다
+func (s *UserService) characterSlotLimit(slotLimit int) int {
+	if slotLimit <= 0 {
+		return s.characterSlotDefault
+	}
+	return min(slotLimit, def.MaxCharacterSlot)
+}
+
+// 계정 공용 인벤토리의 아이템을 소모하여 캐릭터 슬롯을 하나씩 확장합니다
+// 아이템 소모 후 다른 요청과 충돌하여 확장하지 못하면 소모한 아이템을 되돌립니다
+func (s *UserService) ExpandCharacterSlots(ctx context.Context, uids []string) (map[string]UserExpandCharacterSlotResult, error) {
+	ret := make(map[string]UserExpandCharacterSlotResult, len(uids))
+	if len(uids) == 0 {
+		return ret, nil
+	}
+
+	if s.characterSlotExpandItem == "" {
+		for _, uid := range uids {
+			ret[uid] = UserExpandCharacterSlotResult{ErrorCode: USER_EXPAND_CHARACTER_SLOT_DISABLED_ERROR}
+		}
+		return ret, nil
+	}
+
+	if s.inventoryServiceHandler == nil {
+		return ret, ErrInventoryServiceHandlerIsNil
+	}
+
+	storedLimits, err := s.userRepo.FindCharacterSlotLimits(ctx, uids)
+	if err != nil {
+		return ret, err
+	}
+
+	for _, uid := range uids {
+		stored, ok := storedLimits[uid]
+		if !ok {
+			ret[uid] = UserExpandCharacterSlotResult{ErrorCode: USER_EXPAND_CHARACTER_SLOT_USER_NOT_FOUND}
+			continue
+		}
+
+		current := s.characterSlotLimit(stored)
+		if current >= def.MaxCharacterSlot {
+			ret[uid] = UserExpandCharacterSlotResult{SlotLimit: current, ErrorCode: USER_EXPAND_CHARACTER_SLOT_MAX_ERROR}
+			continue
+		}
+
+		consumed, errCode, err := s.inventoryServiceHandler.ConsumeAccountItem(ctx, uid, s.characterSlotExpandItem, s.characterSlotExpandItemCount)
+		if err != nil {
+			log.Err(err).Msgf("캐릭터 슬롯 확장 비용 소모 실패: %v", uid)
+			ret[uid] = UserExpandCharacterSlotResult{SlotLimit: current, ErrorCode: USER_EXPAND_CHARACTER_SLOT_DB_WRITE_ERROR}
+			continue
+		}
+		if errCode != "" {
+			ret[uid] = UserExpandCharacterSlotResult{SlotLimit: current, ErrorCode: USER_EXPAND_CHARACTER_SLOT_COST_NOT_ENOUGH_ERROR}
+			continue
+		}
+
+		updated, err := s.userRepo.UpdateCharacterSlotLimit(ctx, uid, stored, current+1)
+		if err != nil || !updated {
+			errCode := USER_EXPAND_CHARACTER_SLOT_ALREADY_REQUEST
+			if err != nil {
+				log.Err(err).Msgf("캐릭터 슬롯 확장 실패: %v", uid)
+				errCode = USER_EXPAND_CHARACTER_SLOT_DB_WRITE_ERROR
+			}
+
+			// 소모한 묶음을 그대로 되돌려 귀속 여부를 유지합니다
+			if err := s.inventoryServiceHandler.RestoreAccountItems(ctx, consumed); err != nil {
+				log.Err(err).Msgf("캐릭터 슬롯 확장 비용 반환 실패: %v", uid)
+			}
+
+			ret[uid] = UserExpandCharacterSlotResult{SlotLimit: current, ErrorCode: errCode}
+			continue
+		}
+
+		ret[uid] = UserExpandCharacterSlotResult{SlotLimit: current + 1}
+	}
+
+	return ret, nil
+}
+
+func (s *UserService) EquipCharacterItems(ctx context.Context, equipInfos []*UserEquipCharacter) (map[string]UserCharacterEquipResult, error) {
+	if len(equipInfos) == 0 {
+		return map[string]UserCharacterEquipResult{}, nil
+	}
+
+	userCharacters, err := s.FindCharactersByUids(ctx, func() []string {
+		uids := make([]string, 0, len(equipInfos))
+		for _, info := range equipInfos {
+			uids = append(uids, info.Uid)
+		}
+		return uids
+	}())
+	if err != nil {
+		return map[string]UserCharacterEquipResult{}, err
+	}
+
+	if s.inventoryServiceHandler == nil {
+		return map[string]UserCharacterEquipResult{}, ErrInventoryServiceHandlerIsNil
+	}
+
+	uidSlots := make(map[string]int, len(equipInfos))
+	for _, info := range equipInfos {
+		uidSlots[info.Uid] = info.Slot
+	}
+
+	ownedItems, err := s.inventoryServiceHandler.FindOwnedItemIndexes(ctx, uidSlots)
+	if err != nil {
+		return map[string]UserCharacterEquipResult{}, err
+	}
+
+	tables := s.tables.Load()
+	ret := make(map[string]UserCharacterEquipResult, len(equipInfos))
+	params := make([]*UserUpdateCharacterEquips, 0, len(equipInfos))
+	for _, info := range equipInfos {
+		character := findCharacterBySlot(userCharacters[info.Uid], info.Slot)
+		if character == nil {
+			ret[info.Uid] = UserCharacterEquipResult{ErrorCode: USER_EQUIP_CHARACTER_SLOT_NOT_FOUND_ERROR}
+			continue
+		}
+
+		equipType, errorCode := tables.validateEquipItem(character.Gender, info.Index)
+		if errorCode != "" {
+			ret[info.Uid] = UserCharacterEquipResult{ErrorCode: errorCode}
+			continue
+		}
+
+		// 인벤토리에 보관하는 장비는 캐릭터 인벤토리나 계정 공용 인벤토리에 있어야 장착할 수 있습니다 (귀속 여부 무관, 장착해도 소모하지 않습니다)
+		if tables.isStorableItem(info.Index) && !ownedItems[info.Uid][info.Index] {
+			ret[info.Uid] = UserCharacterEquipResult{ErrorCode: USER_EQUIP_ITEM_NOT_OWNED_ERROR}
+			continue
+		}
+
+		equips := make(map[types.CharacterEquipType]string, len(character.Equips)+1)
+		for _, equip := range character.Equips {
+			equips[equip.Type] = equip.Index
+		}
+
+		if equips[equipType] == info.Index {
+			ret[info.Uid] = UserCharacterEquipResult{ErrorCode: USER_EQUIP_ITEM_ALREADY_EQUIPPED_ERROR}
+			continue
+		}
+
+		// 같은 장비 슬롯을 사용하거나 함께 장착할 수 없는 장비는 해제합니다 (한벌옷 ↔ 상의/하의, 두손 무기 ↔ 한손/보조 무기)
+		for t := range equips {
+			if t.SlotType() == equipType.SlotType() {
+				delete(equips, t)
+			}
+		}
+		for _, t := range equipType.ExclusiveTypes() {
+			delete(equips, t)
+		}
+		equips[equipType] = info.Index
+
+		update := &UserUpdateCharacterEquips{
+			Uid:        info.Uid,
+			Slot:       info.Slot,
+			PrevEquips: character.Equips,
+			Equips:     entity.NewCharacterEquips(equips),
+		}
+		ret[info.Uid] = UserCharacterEquipResult{Equips: update.Equips}
+		params = append(params, update)
+	}
+
+	if err := s.updateCharacterEquips(ctx, params, ret); err != nil {
+		return map[string]UserCharacterEquipResult{}, err
+	}
+
+	return ret, nil
+}
+
+func (s *UserService) UnequipCharacterItems(ctx context.Context, unequipInfos []*UserUnequipCharacter) (map[string]UserCharacterEquipResult, error) {
+	if len(unequipInfos) == 0 {
+		return map[string]UserCharacterEquipResult{}, nil
+	}
+
+	userCharacters, err := s.FindCharactersByUids(ctx, func() []string {
+		uids := make([]string, 0, len(unequipInfos))
+		for _, info := range unequipInfos {
+			uids = append(uids, info.Uid)
+		}
+		return uids
+	}())
+	if err != nil {
+		return map[string]UserCharacterEquipResult{}, err
+	}
+
+	ret := make(map[string]UserCharacterEquipResult, len(unequipInfos))
+	params := make([]*UserUpdateCharacterEquips, 0, len(unequipInfos))
+	for _, info := range unequipInfos {
+		if !info.Type.IsValid() {
+			ret[info.Uid] = UserCharacterEquipResult{ErrorCode: USER_UNEQUIP_TYPE_INVALID_ERROR}
+			continue
+		}
+
+		if info.Type.IsRequired() {
+			ret[info.Uid] = UserCharacterEquipResult{ErrorCode: USER_UNEQUIP_TYPE_REQUIRED_ERROR}
+			continue
+		}
+
+		character := findCharacterBySlot(userCharacters[info.Uid], info.Slot)
+		if character == nil {
+			ret[info.Uid] = UserCharacterEquipResult{ErrorCode: USER_EQUIP_CHARACTER_SLOT_NOT_FOUND_ERROR}
+			continue
+		}
+
+		equips := make([]*entity.CharacterEquip, 0, len(character.Equips))
+		for _, equip := range character.Equips {
+			if equip.Type != info.Type {
+				equips = append(equips, equip)
+			}
+		}
+
+		if len(equips) == len(character.Equips) {
+			ret[info.Uid] = UserCharacterEquipResult{ErrorCode: USER_UNEQUIP_NOT_EQUIPPED_ERROR}
+			continue
+		}
+
+		ret[info.Uid] = UserCharacterEquipResult{Equips: equips}
+		params = append(params, &UserUpdateCharacterEquips{
+			Uid:        info.Uid,
+			Slot:       info.Slot,
+			PrevEquips: character.Equips,
+			Equips:     equips,
+		})
+	}
+
+	if err := s.updateCharacterEquips(ctx, params, ret); err != nil {
+		return map[string]UserCharacterEquipResult{}, err
+	}
+
+	return ret, nil
+}
+
+func (s *UserService) updateCharacterEquips(ctx context.Context, params []*UserUpdateCharacterEquips, ret map[string]UserCharacterEquipResult) error {
+	failureUids, err := s.userRepo.UpdateCharacterEquips(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	for uid, failureCode := range failureUids {
+		ret[uid] = UserCharacterEquipResult{ErrorCode: failureCode}
+	}
+	return nil
+}
+
+// 캐릭터 생성 롤백이나 캐릭터 이름 삭제에 실패하여 캐릭터 없이 남은 이름을 주기적으로 정리합니다
+// 한 번에 characterNameJanitorBatchSize개씩 이름 순으로 검사하며, 끝까지 검사하면 처음부터 다시 검사합니다
+func (s *UserService) characterNameJanitor(ctx context.Context) {
+	ticker := time.NewTicker(characterNameJanitorInterval)
+	defer ticker.Stop()
+
+	var lastKey string
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if s.userRepo == nil {
+				continue
+			}
+
+			next, reclaimed, err := s.userRepo.ReclaimOrphanCharacterNames(ctx, lastKey, now.UTC().Add(-characterNameOrphanGrace), characterNameJanitorBatchSize)
+			if err != nil {
+				log.Err(err).Msg("캐릭터 이름 정리 중 오류 발생")
+				continue
+			}
+
+			if reclaimed > 0 {
+				log.Info().Msgf("캐릭터가 없는 캐릭터 이름 %d개를 정리했습니다", reclaimed)
+			}
+			lastKey = next
+		}
+	}
+}
+
+// 트랜잭션 없이 캐릭터를 생성, 삭제하다 중단되거나 되돌리지 못한 작업을 작업 기록으로 주기적으로 복구합니다
+func (s *UserService) characterOutboxWorker(ctx context.Context) {
+	ticker := time.NewTicker(characterOutboxInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if s.userRepo == nil {
+				continue
+			}
+
+			recovered, err := s.userRepo.RecoverCharacterOutboxes(ctx, now.UTC().Add(-characterOutboxGrace), characterOutboxBatchSize)
+			if err != nil {
+				log.Err(err).Msg("캐릭터 작업 기록 복구 중 오류 발생")
+				continue
+			}
+
+			if recovered > 0 {
+				log.Info().Msgf("캐릭터 작업 기록 %d개를 복구했습니다", recovered)
+			}
+		}
+	}
+}
+
+// 유예 기간이 지난 삭제된 캐릭터를 주기적으로 완전 삭제하고 해당 캐릭터의 인벤토리를 정리합니다
+// 인벤토리를 먼저 정리하고 삭제된 캐릭터 기록은 마지막에 삭제하므로, 도중에 실패하면 다음 주기에 다시 정리합니다
+func (s *UserService) deletedCharacterPurger(ctx context.Context) {
+	ticker := time.NewTicker(deletedCharacterPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if s.userRepo == nil || s.inventoryServiceHandler == nil {
+				continue
+			}
+
+			now = now.UTC()
+			expired, err := s.userRepo.FindExpiredDeletedCharacters(ctx, now, deletedCharacterPurgeBatchSize)
+			if err != nil {
+				log.Err(err).Msg("완전 삭제할 캐릭터 조회 중 오류 발생")
+				continue
+			}
+
+			if len(expired) == 0 {
+				continue
+			}
+
+			// 한 유저의 여러 캐릭터가 완전 삭제될 수 있으므로 유저별로 슬롯 하나씩 나누어 정리
+			cleaned := make([]*entity.DeletedCharacter, 0, len(expired))
+			for len(expired) > 0 {
+				uidSlots := make(map[string]int, len(expired))
+				batch := make([]*entity.DeletedCharacter, 0, len(expired))
+				remains := make([]*entity.DeletedCharacter, 0, len(expired))
+				for _, deleted := range expired {
+					if _, ok := uidSlots[deleted.Uid]; ok {
+						remains = append(remains, deleted)
+						continue
+					}
+					uidSlots[deleted.Uid] = deleted.Character.Slot
+					batch = append(batch, deleted)
+				}
+				expired = remains
+
+				if err := s.inventoryServiceHandler.DeleteCharacterInventories(ctx, uidSlots); err != nil {
+					log.Err(err).Msg("완전 삭제할 캐릭터 인벤토리 정리 중 오류 발생")
+					continue
+				}
+				cleaned = append(cleaned, batch...)
+			}
+
+			purged := s.userRepo.PurgeDeletedCharacters(ctx, cleaned, now)
+			if len(purged) > 0 {
+				log.Info().Msgf("삭제된 캐릭터 %d개를 완전 삭제했습니다", len(purged))
+			}
+		}
 	}
-	return s.userRepo.DeleteCharacters(ctx, deleteInfos)
 }
@@ -0,0 +1,119 @@
+package user
+
+import (
+	"MScannot206/shared/def"
+	"MScannot206/shared/table"
+	"MScannot206/shared/table/view"
+	"MScannot206/shared/types"
+	"errors"
+)
+
+// 유저 서비스가 사용하는 테이블 스냅샷
+// 요청 처리 중에는 하나의 스냅샷만 사용하여 테이블 리로드 중에도 일관된 테이블을 보장합니다
+type userTables struct {
+	// 아이템 테이블
+	item *table.ItemTable
+
+	// 캐릭터 장착 아이템 테이블
+	characterEquipItem *table.CharacterEquipItemTable
+
+	// 캐릭터 생성 테이블 뷰
+	createCharacterView view.CreateCharacterView
+
+	// 로케일별 캐릭터 이름 정책
+	characterNamePolicies map[def.Locale]*CharacterNamePolicy
+}
+
+func newUserTables(tableRepo *table.Repository) (*userTables, error) {
+	var errs error
+
+	item, err := table.Lookup[*table.ItemTable](tableRepo)
+	if err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	characterEquipItem, err := table.Lookup[*table.CharacterEquipItemTable](tableRepo)
+	if err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	createCharacterView, err := view.NewCreateCharacterView(tableRepo)
+	if err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	nameFilter, err := table.Lookup[*table.NameFilterTable](tableRepo)
+	if err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	if errs != nil {
+		return nil, errs
+	}
+
+	return &userTables{
+		item:                  item,
+		characterEquipItem:    characterEquipItem,
+		createCharacterView:   createCharacterView,
+		characterNamePolicies: newCharacterNamePolicies(nameFilter),
+	}, nil
+}
+
+// 로케일의 캐릭터 이름 정책으로 캐릭터 이름을 검사합니다 (정책이 없는 로케일은 영어 정책을 사용합니다)
+func (t *userTables) validateCharacterName(name string, locale def.Locale) string {
+	policy, ok := t.characterNamePolicies[locale]
+	if !ok {
+		policy = t.characterNamePolicies[def.LocaleEnglish]
+	}
+	return policy.Validate(name)
+}
+
+// 캐릭터 생성 시 직접 선택한 외형을 캐릭터 생성 테이블로 검증합니다
+func (t *userTables) validateAppearance(gender int, appearance map[types.CharacterEquipType]string) string {
+	for equipType, index := range appearance {
+		if !view.IsAppearanceType(equipType) {
+			return USER_CREATE_CHARACTER_APPEARANCE_TYPE_INVALID_ERROR
+		}
+
+		if !t.createCharacterView.IsSelectableAppearance(gender, equipType, index) {
+			return USER_CREATE_CHARACTER_APPEARANCE_INDEX_INVALID_ERROR
+		}
+	}
+
+	return ""
+}
+
+// 장착할 아이템을 캐릭터 장착 아이템 테이블로 검증하고 장비 종류를 반환합니다
+func (t *userTables) validateEquipItem(gender int, index string) (types.CharacterEquipType, string) {
+	equipType := types.GetCharacterEquipTypeByIndex(index)
+	if equipType == types.CharacterEquipType_None {
+		return equipType, USER_EQUIP_ITEM_TYPE_INVALID_ERROR
+	}
+
+	record, ok := t.characterEquipItem.Get(index)
+	if !ok {
+		return equipType, USER_EQUIP_ITEM_NOT_FOUND_ERROR
+	}
+
+	switch gender {
+	case types.GenderType_Male:
+		if !record.Male {
+			return equipType, USER_EQUIP_ITEM_GENDER_MISMATCH_ERROR
+		}
+	case types.GenderType_Female:
+		if !record.Female {
+			return equipType, USER_EQUIP_ITEM_GENDER_MISMATCH_ERROR
+		}
+	default:
+		return equipType, USER_EQUIP_ITEM_GENDER_MISMATCH_ERROR
+	}
+
+	return equipType, ""
+}
+
+// 인벤토리에 보관하는 아이템인지 확인합니다
+// 헤어, 얼굴 등 인벤토리에 보관하지 않는 외형 아이템은 소유하지 않아도 장착할 수 있습니다
+func (t *userTables) isStorableItem(index string) bool {
+	record, ok := t.item.Get(index)
+	return ok && record.InventoryType != types.InventoryType_None
+}
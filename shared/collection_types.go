@@ -2,10 +2,22 @@ package shared
 
 var User = "user"
 var UserSession = "user_session"
+var UserIdentity = "user_identity"
 
 var CharacterName = "character_name"
+var CharacterDraft = "character_draft"
+var CharacterOutbox = "character_outbox"
+var DeletedCharacter = "deleted_character"
 
 var Channel = "channel"
 var ChannelRecycle = "channel_recycle"
 
 var Counter = "counter"
+
+var Inventory = "inventory"
+
+var Random = "random"
+
+var UserReward = "user_reward"
+
+var Migration = "migration"
@@ -8,6 +8,76 @@ type WebServerConfig struct {
 
 	DataTablePath string `yaml:"data_table_path"`
 
+	// 데이터 테이블 변경 감시 주기(초), 0이면 감시하지 않음
+	DataTableWatchInterval int `yaml:"data_table_watch_interval"`
+
+	// 관리자 API 키, 비어 있으면 관리자 API를 사용할 수 없음
+	AdminKey string `yaml:"admin_key"`
+
+	// 클리커 미니게임 초당 허용 클릭 수, 0이면 기본값 사용
+	ClickerMaxClicksPerSecond int `yaml:"clicker_max_clicks_per_second"`
+
+	// 삭제된 캐릭터를 복구할 수 있는 유예 시간(시간), 0이면 기본값 사용
+	CharacterDeleteGraceHours int `yaml:"character_delete_grace_hours"`
+
+	// 캐릭터 이름을 다시 변경할 수 있는 대기 시간(시간), 0이면 기본값 사용
+	CharacterRenameCooldownHours int `yaml:"character_rename_cooldown_hours"`
+
+	// 유저 캐릭터 슬롯 수 기본값, 0이면 기본값 사용
+	CharacterSlotDefault int `yaml:"character_slot_default"`
+
+	// 캐릭터 슬롯 확장 시 소모하는 아이템 인덱스, 비어 있으면 확장할 수 없음
+	CharacterSlotExpandItem string `yaml:"character_slot_expand_item"`
+
+	// 캐릭터 슬롯 확장 시 소모하는 아이템 개수, 0이면 1개
+	CharacterSlotExpandItemCount int64 `yaml:"character_slot_expand_item_count"`
+
+	// 사용할 인증 수단 목록 (password, device, legacy), 비어 있으면 password, device 사용
+	// legacy는 이전 버전 유저의 전환 기간에만 사용합니다
+	AuthProviders []string `yaml:"auth_providers"`
+
+	// 비밀번호 해시 방식 (bcrypt, argon2id), 비어 있으면 bcrypt
+	AuthPasswordHasher string `yaml:"auth_password_hasher"`
+
+	// 외부 ID 토큰 인증 수단 목록
+	AuthIdTokenProviders []AuthIdTokenProviderConfig `yaml:"auth_id_token_providers"`
+
+	// 액세스 토큰 유효 시간(초), 0이면 기본값 사용
+	AuthAccessTokenTtlSeconds int `yaml:"auth_access_token_ttl_seconds"`
+
+	// 리프레시 토큰 유효 시간(시간), 0이면 기본값 사용
+	AuthRefreshTokenTtlHours int `yaml:"auth_refresh_token_ttl_hours"`
+
+	// 액세스 토큰 서명 키 목록 (필수), 첫 번째 키로 서명하고 나머지는 검증에만 사용
+	AuthTokenKeys []AuthTokenKeyConfig `yaml:"auth_token_keys"`
+
+	// 랜덤 추첨마다 스트림 시드와 추첨 번호를 로그로 남길지 여부
+	RandomLogDraws bool `yaml:"random_log_draws"`
+
 	MongoUri       string `yaml:"mongo_uri"`
 	MongoEnvDBName string `yaml:"mongo_env_db_name"`
 }
+
+// 외부 ID 토큰 인증 수단 설정
+type AuthIdTokenProviderConfig struct {
+	// 인증 수단 이름, 로그인 요청의 provider로 사용합니다
+	Name string `yaml:"name"`
+
+	// ID 토큰 서명 검증에 사용할 공개 키 PEM 파일 경로 (PUBLIC KEY 또는 CERTIFICATE)
+	PublicKeyPath string `yaml:"public_key_path"`
+
+	// 허용하는 토큰 발급자(iss), 필수
+	Issuer string `yaml:"issuer"`
+
+	// 허용하는 토큰 대상(aud), 필수 (보통 외부 서비스에 등록한 클라이언트 ID)
+	Audience string `yaml:"audience"`
+}
+
+// 액세스 토큰 서명 키 설정
+type AuthTokenKeyConfig struct {
+	// 키 ID, 토큰에 함께 기록됩니다 ('.' 사용 불가)
+	Id string `yaml:"id"`
+
+	// HMAC 서명 비밀 값 (32바이트 이상)
+	Secret string `yaml:"secret"`
+}
@@ -1,5 +1,14 @@
 package def
 
-const MaxCharacterSlot = 4
-const MinCharacterNameLength = 1
-const MaxCharacterNameLength = 12
+// 유저가 확장할 수 있는 최대 캐릭터 슬롯 수
+const MaxCharacterSlot = 12
+
+// 유저 캐릭터 슬롯 수 기본값 (설정으로 변경할 수 있습니다)
+const DefaultCharacterSlot = 4
+
+// 캐릭터 이름 표시 폭 (한글, 한자, 가나는 2칸, 그 외 문자는 1칸)
+const MinCharacterNameWidth = 1
+const MaxCharacterNameWidth = 12
+
+// 계정 공용 인벤토리 슬롯 (1 이상은 캐릭터 슬롯)
+const AccountInventorySlot = 0
@@ -1,6 +1,11 @@
 package entity
 
-import "MScannot206/shared/types"
+import (
+	"MScannot206/shared/types"
+	"cmp"
+	"slices"
+	"time"
+)
 
 // 캐릭터 엔티티를 생성 합니다
 func NewCharacter(slot int, name string) *Character {
@@ -20,15 +25,110 @@ type Character struct {
 
 	// 캐릭터 성별
 	Gender int `json:"gender" bson:"gender"`
+
+	// 캐릭터 장비 목록
+	Equips []*CharacterEquip `json:"equips,omitempty" bson:"equips,omitempty"`
+
+	// 이전 캐릭터 이름 기록 (변경 순, 운영 추적용)
+	NameHistory []*CharacterNameHistory `json:"-" bson:"name_history,omitempty"`
+}
+
+// 캐릭터 이름 변경 기록 엔티티 구조체
+type CharacterNameHistory struct {
+	// 변경 전 캐릭터 이름
+	Name string `json:"name" bson:"name"`
+
+	// 변경 일시
+	ChangedAt time.Time `json:"changed_at" bson:"changed_at"`
+}
+
+// 마지막으로 이름을 변경한 일시를 반환합니다 (변경한 적이 없으면 false)
+func (c *Character) LastRenamedAt() (time.Time, bool) {
+	if len(c.NameHistory) == 0 {
+		return time.Time{}, false
+	}
+	return c.NameHistory[len(c.NameHistory)-1].ChangedAt, true
+}
+
+// 삭제된 캐릭터 엔티티 구조체 (유예 기간 동안 보관하며, 그동안 캐릭터 이름과 슬롯은 다른 캐릭터가 사용할 수 없습니다)
+type DeletedCharacter struct {
+	// 삭제 고유 ID
+	Id string `json:"-" bson:"_id"`
+
+	// 유저 고유 ID
+	Uid string `json:"-" bson:"uid"`
+
+	// 삭제된 캐릭터
+	Character *Character `json:"character" bson:"character"`
+
+	// 삭제 일시
+	DeletedAt time.Time `json:"deleted_at" bson:"deleted_at"`
+
+	// 완전 삭제 일시 (이후 캐릭터 이름과 슬롯이 해제되며 복구할 수 없습니다)
+	PurgeAt time.Time `json:"purge_at" bson:"purge_at"`
 }
 
 // 캐릭터 이름 엔티티 구조체
 type CharacterName struct {
-	// 캐릭터 이름
-	Name string `bson:"_id"`
+	// 캐릭터 이름 정규 키 (NFKC 정규화, 대소문자 통합), 대소문자나 전각 문자만 다른 이름은 같은 키를 사용합니다
+	Key string `bson:"_id"`
+
+	// 표시용 캐릭터 이름 (사용자가 입력한 이름)
+	Name string `bson:"name"`
+
+	// 이름을 사용(예약)하는 유저 고유 ID
+	Uid string `bson:"uid,omitempty"`
 
 	// 생성 일시
 	CreatedAt int64 `bson:"created_at"`
+
+	// 예약 만료 일시 (예약 중인 이름에만 존재하며 TTL 인덱스로 삭제됩니다)
+	ExpireAt *time.Time `bson:"expire_at,omitempty"`
+}
+
+// 캐릭터 생성 초안 엔티티 구조체 (미리보기로 결정된 장비를 캐릭터 생성 전까지 보관합니다)
+type CharacterDraft struct {
+	// 유저 고유 ID
+	Uid string `bson:"uid"`
+
+	// 생성할 캐릭터 슬롯 번호
+	Slot int `bson:"slot"`
+
+	// 생성할 캐릭터 성별
+	Gender int `bson:"gender"`
+
+	// 미리보기로 결정된 장비 목록
+	Equips []*CharacterEquip `bson:"equips"`
+
+	// 다시 굴린 횟수
+	Rerolls int `bson:"rerolls"`
+
+	// 만료 일시 (TTL 인덱스로 삭제됩니다)
+	ExpireAt time.Time `bson:"expire_at"`
+}
+
+// 캐릭터 작업 기록 엔티티 구조체 (트랜잭션 없이 캐릭터를 생성, 삭제할 때 중단된 작업을 복구하기 위해 남깁니다)
+type CharacterOutbox struct {
+	// 작업 기록 고유 ID
+	Id string `bson:"_id"`
+
+	// 작업 종류 (create_character, delete_character, restore_character, rename_character)
+	Type string `bson:"type"`
+
+	// 유저 고유 ID
+	Uid string `bson:"uid"`
+
+	// 캐릭터 슬롯 번호
+	Slot int `bson:"slot"`
+
+	// 캐릭터 이름 (이름 변경은 변경할 이름)
+	Name string `bson:"name"`
+
+	// 변경 전 캐릭터 이름 (이름 변경에만 존재합니다)
+	OldName string `bson:"old_name,omitempty"`
+
+	// 생성 일시
+	CreatedAt time.Time `bson:"created_at"`
 }
 
 // 캐릭터 장비 엔티티 구조체
@@ -40,6 +140,32 @@ type CharacterEquip struct {
 	Index string `json:"index" bson:"index"`
 }
 
+// 장비 종류별 인덱스 맵을 캐릭터 장비 목록으로 변환합니다 (장비 종류 순으로 정렬)
+func NewCharacterEquips(equips map[types.CharacterEquipType]string) []*CharacterEquip {
+	ret := make([]*CharacterEquip, 0, len(equips))
+	for equipType, index := range equips {
+		ret = append(ret, &CharacterEquip{
+			Type:  equipType,
+			Index: index,
+		})
+	}
+
+	slices.SortFunc(ret, func(a, b *CharacterEquip) int {
+		return cmp.Compare(a.Type, b.Type)
+	})
+
+	return ret
+}
+
+// 캐릭터 장비 목록을 장비 종류별 인덱스 맵으로 변환합니다
+func NewCharacterEquipMap(equips []*CharacterEquip) map[types.CharacterEquipType]string {
+	ret := make(map[types.CharacterEquipType]string, len(equips))
+	for _, equip := range equips {
+		ret[equip.Type] = equip.Index
+	}
+	return ret
+}
+
 // 캐릭터 장비 슬롯 엔티티 구조체
 type CharacterEquipSlot struct {
 	// 장비 슬롯 타입
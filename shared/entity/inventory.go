@@ -0,0 +1,17 @@
+package entity
+
+import "MScannot206/shared/types"
+
+// 인벤토리 아이템 엔티티 구조체
+type InventoryItem struct {
+	Item `bson:",inline"`
+
+	// 소유 유저 고유 ID
+	Uid string `json:"-" bson:"uid"`
+
+	// 인벤토리 슬롯 (0: 계정 공용, 1 이상: 캐릭터 슬롯)
+	Slot int `json:"slot" bson:"slot"`
+
+	// 인벤토리 종류
+	InventoryType types.InventoryType `json:"inventory_type" bson:"inventory_type"`
+}
@@ -1,7 +1,18 @@
 package entity
 
-// 게임 내 랜덤 시드 정보를 담는 엔티티입니다
+import "time"
+
+// 게임 내 랜덤 스트림의 시드 정보를 담는 엔티티입니다
 type GameRandom struct {
-	// 캐릭터 생성 시드
-	CharacterCreate int64 `bson:"character_create"`
+	// 랜덤 스트림 이름 (예: character_create)
+	Name string `bson:"_id"`
+
+	// 스트림 시드 (최초 생성 시 한 번만 결정됩니다)
+	Seed int64 `bson:"seed"`
+
+	// 지금까지 예약된 추첨 번호 (다음 예약은 이 번호부터 시작합니다)
+	Draws int64 `bson:"draws"`
+
+	// 마지막 예약 시각
+	UpdatedAt time.Time `bson:"updated_at"`
 }
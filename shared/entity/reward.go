@@ -0,0 +1,16 @@
+package entity
+
+// 유저 보상 뽑기 상태 엔티티 구조체
+type UserReward struct {
+	// 유저 고유 ID
+	Uid string `bson:"_id"`
+
+	// 보상 그룹별 천장 카운터 (천장 보상 없이 연속으로 뽑은 횟수)
+	Pity map[string]int64 `bson:"pity"`
+
+	// 덱 보상 그룹별 남은 보상 인덱스
+	Decks map[string][]string `bson:"decks"`
+
+	// 동시 갱신 확인용 버전
+	Version int64 `bson:"version"`
+}
@@ -19,4 +19,7 @@ type User struct {
 
 	// 유저가 보유한 캐릭터 목록
 	Characters []*Character `json:"characters,omitempty" bson:"characters,omitempty"`
+
+	// 확장한 캐릭터 슬롯 수 (확장한 적이 없으면 0이며 설정의 기본값을 사용합니다)
+	SlotLimit int `json:"slot_limit,omitempty" bson:"slot_limit,omitempty"`
 }
@@ -0,0 +1,24 @@
+package entity
+
+import "time"
+
+// 유저 인증 정보 엔티티 구조체 (인증 수단별 계정을 내부 유저 고유 ID에 연결합니다)
+type UserIdentity struct {
+	// 인증 수단과 계정 ID를 합친 고유 ID ("<provider>:<subject>")
+	Id string `bson:"_id"`
+
+	// 인증 수단 이름 (password, device, 외부 ID 토큰 발급자 이름 등)
+	Provider string `bson:"provider"`
+
+	// 인증 수단 내에서 계정을 식별하는 ID
+	Subject string `bson:"subject"`
+
+	// 연결된 유저 고유 ID
+	Uid string `bson:"uid"`
+
+	// 비밀번호 해시 (비밀번호 인증 수단에만 존재합니다)
+	PasswordHash string `bson:"password_hash,omitempty"`
+
+	// 생성 일시
+	CreatedAt time.Time `bson:"created_at"`
+}
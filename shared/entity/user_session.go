@@ -3,7 +3,25 @@ package entity
 import "time"
 
 type UserSession struct {
-	Uid       string    `bson:"_id"`
-	Token     string    `bson:"token"`
+	Uid string `bson:"_id"`
+
+	// 액세스 토큰, 서명으로 검증하므로 저장하지 않습니다
+	Token string `bson:"-"`
+
+	// 액세스 토큰 만료 시각
+	TokenExpireAt time.Time `bson:"-"`
+
+	// 리프레시 토큰, 발급할 때만 채워지며 해시만 저장합니다
+	RefreshToken string `bson:"-"`
+
+	// 리프레시 토큰 해시
+	RefreshTokenHash string `bson:"refresh_token_hash"`
+
+	// 교체되기 전 리프레시 토큰 해시, 이미 사용한 리프레시 토큰의 재사용을 감지합니다
+	PrevRefreshTokenHash string `bson:"prev_refresh_token_hash,omitempty"`
+
+	// 리프레시 토큰 만료 시각 (세션 만료 시각)
+	ExpireAt time.Time `bson:"expire_at"`
+
 	UpdatedAt time.Time `bson:"updated_at"`
 }
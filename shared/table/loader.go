@@ -0,0 +1,287 @@
+package table
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"iter"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// 생성된 테이블 Load 함수가 사용하는 CSV 로더입니다
+// 헤더 이름으로 컬럼을 찾으며, 모든 오류는 파일/행/컬럼 정보를 포함한 ParseError로 반환합니다
+
+var ErrMissingColumn = errors.New("missing column")
+var ErrColumnCount = errors.New("column count mismatch")
+var ErrEmptyValue = errors.New("empty value")
+var ErrDuplicateKey = errors.New("duplicate key")
+var ErrInvalidEnum = errors.New("invalid enum value")
+
+// 테이블 파싱 오류
+type ParseError struct {
+	// CSV 파일 이름
+	File string
+
+	// 행 번호 (헤더가 1행)
+	Row int
+
+	// 컬럼 이름
+	Column string
+
+	// 원인 오류
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	switch {
+	case e.Row == 0:
+		return fmt.Sprintf("%s: %v", e.File, e.Err)
+	case e.Column == "":
+		return fmt.Sprintf("%s:%d: %v", e.File, e.Row, e.Err)
+	default:
+		return fmt.Sprintf("%s:%d:%s: %v", e.File, e.Row, e.Column, e.Err)
+	}
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// CSV 파일을 읽어 헤더와 행을 보관합니다
+type csvTableReader struct {
+	file    string
+	columns map[string]int
+	records [][]string
+}
+
+// CSV 파일을 읽고 필요한 컬럼이 헤더에 모두 있는지 확인합니다
+func newCsvTableReader(csvPath string, columns ...string) (*csvTableReader, error) {
+	fileName := filepath.Base(csvPath)
+
+	file, err := os.Open(csvPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	r := csv.NewReader(file)
+	r.FieldsPerRecord = -1
+
+	records, err := r.ReadAll()
+	if err != nil {
+		var csvErr *csv.ParseError
+		if errors.As(err, &csvErr) {
+			return nil, &ParseError{File: fileName, Row: csvErr.Line, Err: csvErr.Err}
+		}
+		return nil, &ParseError{File: fileName, Err: err}
+	}
+
+	if len(records) == 0 {
+		return nil, &ParseError{File: fileName, Row: 1, Err: ErrMissingColumn}
+	}
+
+	header := records[0]
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	reader := &csvTableReader{
+		file:    fileName,
+		columns: make(map[string]int, len(header)),
+		records: records,
+	}
+	for i, name := range header {
+		reader.columns[strings.TrimSpace(name)] = i
+	}
+
+	var errs error
+	for _, column := range columns {
+		if _, ok := reader.columns[column]; !ok {
+			errs = errors.Join(errs, &ParseError{File: fileName, Row: 1, Column: column, Err: ErrMissingColumn})
+		}
+	}
+	if errs != nil {
+		return nil, errs
+	}
+
+	return reader, nil
+}
+
+// 헤더를 제외한 행을 순회합니다 (빈 행은 건너뜁니다)
+func (r *csvTableReader) Rows() iter.Seq[*csvRow] {
+	return func(yield func(*csvRow) bool) {
+		header := r.records[0]
+		for i, record := range r.records[1:] {
+			if len(record) == 1 && record[0] == "" {
+				continue
+			}
+
+			row := &csvRow{reader: r, row: i + 2, record: record}
+			if len(record) != len(header) {
+				row.fail("", fmt.Errorf("%w: expected %d, got %d", ErrColumnCount, len(header), len(record)))
+			}
+
+			if !yield(row) {
+				return
+			}
+		}
+	}
+}
+
+// CSV의 한 행이며 컬럼 값을 읽는 중 발생한 오류를 모아둡니다
+type csvRow struct {
+	reader *csvTableReader
+	row    int
+	record []string
+	err    error
+}
+
+// 행을 읽는 중 발생한 오류를 반환합니다
+func (r *csvRow) Err() error {
+	return r.err
+}
+
+// 해당 컬럼에 대한 ParseError를 생성합니다
+func (r *csvRow) Error(column string, err error) error {
+	return &ParseError{File: r.reader.file, Row: r.row, Column: column, Err: err}
+}
+
+func (r *csvRow) fail(column string, err error) {
+	r.err = errors.Join(r.err, r.Error(column, err))
+}
+
+func (r *csvRow) value(column string) string {
+	i, ok := r.reader.columns[column]
+	if !ok || i >= len(r.record) {
+		return ""
+	}
+	return r.record[i]
+}
+
+// 키 컬럼 값을 읽습니다 (빈 값은 허용하지 않습니다)
+func (r *csvRow) Key(column string) string {
+	v := r.value(column)
+	if strings.TrimSpace(v) == "" {
+		r.fail(column, ErrEmptyValue)
+	}
+	return v
+}
+
+// 문자열 컬럼 값을 읽습니다
+func (r *csvRow) String(column string) string {
+	return r.value(column)
+}
+
+// 정수 컬럼 값을 읽습니다 (빈 값은 허용하지 않습니다)
+func (r *csvRow) Int(column string) int64 {
+	v := strings.TrimSpace(r.value(column))
+	if v == "" {
+		r.fail(column, ErrEmptyValue)
+		return 0
+	}
+	return r.parseInt(column, v)
+}
+
+// 정수 컬럼 값을 읽습니다 (빈 값은 기본값을 사용합니다)
+func (r *csvRow) IntOr(column string, def int64) int64 {
+	v := strings.TrimSpace(r.value(column))
+	if v == "" {
+		return def
+	}
+	return r.parseInt(column, v)
+}
+
+func (r *csvRow) parseInt(column string, v string) int64 {
+	intVal, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		r.fail(column, err)
+	}
+	return intVal
+}
+
+// 실수 컬럼 값을 읽습니다 (빈 값은 허용하지 않습니다)
+func (r *csvRow) Float(column string) float64 {
+	v := strings.TrimSpace(r.value(column))
+	if v == "" {
+		r.fail(column, ErrEmptyValue)
+		return 0
+	}
+	return r.parseFloat(column, v)
+}
+
+// 실수 컬럼 값을 읽습니다 (빈 값은 기본값을 사용합니다)
+func (r *csvRow) FloatOr(column string, def float64) float64 {
+	v := strings.TrimSpace(r.value(column))
+	if v == "" {
+		return def
+	}
+	return r.parseFloat(column, v)
+}
+
+func (r *csvRow) parseFloat(column string, v string) float64 {
+	floatVal, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		r.fail(column, err)
+	}
+	return floatVal
+}
+
+// 불리언 컬럼 값을 읽습니다 (빈 값은 허용하지 않습니다)
+func (r *csvRow) Bool(column string) bool {
+	v := strings.TrimSpace(r.value(column))
+	if v == "" {
+		r.fail(column, ErrEmptyValue)
+		return false
+	}
+	return r.parseBool(column, v)
+}
+
+// 불리언 컬럼 값을 읽습니다 (빈 값은 기본값을 사용합니다)
+func (r *csvRow) BoolOr(column string, def bool) bool {
+	v := strings.TrimSpace(r.value(column))
+	if v == "" {
+		return def
+	}
+	return r.parseBool(column, v)
+}
+
+func (r *csvRow) parseBool(column string, v string) bool {
+	boolVal, err := strconv.ParseBool(v)
+	if err != nil {
+		r.fail(column, err)
+	}
+	return boolVal
+}
+
+// 쉼표로 구분된 문자열 목록 컬럼 값을 읽습니다 (빈 값은 nil, 빈 항목은 제외합니다)
+func (r *csvRow) StringList(column string) []string {
+	v := r.value(column)
+	if strings.TrimSpace(v) == "" {
+		return nil
+	}
+
+	ret := make([]string, 0, strings.Count(v, ",")+1)
+	for item := range strings.SplitSeq(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			ret = append(ret, item)
+		}
+	}
+	return ret
+}
+
+// 열거형 컬럼 값을 읽습니다 (빈 값과 parse가 허용하지 않는 값은 오류입니다)
+func rowEnum[T any](r *csvRow, column string, parse func(string) (T, bool)) T {
+	v := strings.TrimSpace(r.value(column))
+	if v == "" {
+		r.fail(column, ErrEmptyValue)
+	}
+
+	enumVal, ok := parse(v)
+	if !ok && v != "" {
+		r.fail(column, fmt.Errorf("%w: %q", ErrInvalidEnum, v))
+	}
+	return enumVal
+}
@@ -0,0 +1,62 @@
+package table
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// 테이블은 CSV 파일로부터 레코드를 불러오는 생성된 테이블 타입이 구현하는 인터페이스입니다
+type Table interface {
+	Load(csvPath string) error
+}
+
+// 테이블 등록 정보
+type Registration struct {
+	// 테이블 이름
+	Name string
+
+	// 테이블 CSV 파일 이름
+	CsvFile string
+
+	// 빈 테이블 생성 함수
+	New func() Table
+}
+
+var registrations []Registration
+
+// 테이블을 레지스트리에 등록합니다 (생성된 테이블 코드의 init에서 호출됩니다)
+// 이름, CSV 파일, 테이블 타입 중 하나라도 이미 등록되어 있으면 패닉이 발생합니다
+func Register(name string, csvFile string, newTable func() Table) {
+	if name == "" || csvFile == "" || newTable == nil {
+		panic(fmt.Sprintf("table: invalid registration %q (%q)", name, csvFile))
+	}
+
+	tableType := reflect.TypeOf(newTable())
+	for _, reg := range registrations {
+		switch {
+		case reg.Name == name:
+			panic(fmt.Sprintf("table: duplicate table name %q", name))
+		case reg.CsvFile == csvFile:
+			panic(fmt.Sprintf("table: csv file %q is already registered by %q", csvFile, reg.Name))
+		case reflect.TypeOf(reg.New()) == tableType:
+			panic(fmt.Sprintf("table: table type %v is already registered by %q", tableType, reg.Name))
+		}
+	}
+
+	registrations = append(registrations, Registration{
+		Name:    name,
+		CsvFile: csvFile,
+		New:     newTable,
+	})
+}
+
+// 등록된 테이블 정보를 이름 순으로 가져옵니다
+func Registrations() []Registration {
+	ret := slices.Clone(registrations)
+	slices.SortFunc(ret, func(a, b Registration) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return ret
+}
@@ -2,7 +2,14 @@ package table_test
 
 import (
 	"MScannot206/shared/table"
+	"MScannot206/shared/types"
+	"bytes"
+	"errors"
+	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -19,15 +26,288 @@ func TestRepository(t *testing.T) {
 		t.Fatalf("failed to load repository: %v", err)
 	}
 
-	_, ok := r.Item.Get("1")
+	items, ok := table.Get[*table.ItemTable](r)
+	if !ok {
+		t.Fatal("item table not found in repository")
+	}
+
+	_, ok = items.Get("1")
 	if !ok {
 		t.Log("item with key '1' not found in item table")
 	}
 
-	item, ok := r.Item.Get("hair-43")
+	item, ok := items.Get("hair-43")
 	if !ok {
 		t.Log("item with key 'hair-43' not found in item table")
 	} else {
 		t.Logf("item with key 'hair-43' found: %+v", item)
 	}
 }
+
+func TestCharacterEquipItemType(t *testing.T) {
+	r := &table.Repository{}
+
+	absolutePath, err := filepath.Abs("../../data")
+	if err != nil {
+		t.Fatalf("failed to get absolute path: %v", err)
+	}
+
+	if err := r.Load(absolutePath); err != nil {
+		t.Fatalf("failed to load repository: %v", err)
+	}
+
+	equipItems, ok := table.Get[*table.CharacterEquipItemTable](r)
+	if !ok {
+		t.Fatal("character equip item table not found in repository")
+	}
+
+	for _, record := range equipItems.GetAll() {
+		if types.GetCharacterEquipTypeByIndex(record.Index) == types.CharacterEquipType_None {
+			t.Errorf("unknown equip type for index '%s'", record.Index)
+		}
+	}
+}
+
+func TestRepositoryCsvFiles(t *testing.T) {
+	dataPath, err := filepath.Abs("../../data")
+	if err != nil {
+		t.Fatalf("failed to get absolute path: %v", err)
+	}
+
+	tempDir := t.TempDir()
+	for _, reg := range table.Registrations() {
+		if reg.CsvFile == "Item.csv" {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dataPath, reg.CsvFile))
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", reg.CsvFile, err)
+		}
+		if err := os.WriteFile(filepath.Join(tempDir, reg.CsvFile), data, 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", reg.CsvFile, err)
+		}
+	}
+
+	if err := os.WriteFile(filepath.Join(tempDir, "Unknown.csv"), []byte("Index\n"), 0o644); err != nil {
+		t.Fatalf("failed to write Unknown.csv: %v", err)
+	}
+
+	r := &table.Repository{}
+	err = r.Load(tempDir)
+	if !errors.Is(err, table.ErrTableCsvMissing) {
+		t.Errorf("expected missing csv error, got %v", err)
+	}
+	if !errors.Is(err, table.ErrTableCsvNotRegistered) {
+		t.Errorf("expected not registered csv error, got %v", err)
+	}
+
+	if _, ok := table.Get[*table.ItemTable](r); !ok {
+		t.Error("item table should be created even if csv file is missing")
+	}
+}
+
+type unregisteredTable struct{}
+
+func (t *unregisteredTable) Load(csvPath string) error {
+	return nil
+}
+
+func TestRepositoryGetUnregistered(t *testing.T) {
+	if _, ok := table.Get[*table.ItemTable](nil); ok {
+		t.Error("expected no table from nil repository")
+	}
+
+	r := &table.Repository{}
+	if _, ok := table.Get[*table.ItemTable](r); ok {
+		t.Error("expected no table from empty repository")
+	}
+
+	dataPath, err := filepath.Abs("../../data")
+	if err != nil {
+		t.Fatalf("failed to get absolute path: %v", err)
+	}
+	if err := r.Load(dataPath); err != nil {
+		t.Fatalf("failed to load repository: %v", err)
+	}
+
+	if _, err := table.Lookup[*unregisteredTable](r); !errors.Is(err, table.ErrTableNotRegistered) {
+		t.Errorf("expected not registered table error, got %v", err)
+	}
+	if _, err := table.Lookup[*table.ItemTable](r); err != nil {
+		t.Errorf("expected item table, got %v", err)
+	}
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		csvFile  string
+		newTable func() table.Table
+	}{
+		{name: "Item", csvFile: "Unregistered.csv", newTable: func() table.Table { return &unregisteredTable{} }},
+		{name: "Unregistered", csvFile: "Item.csv", newTable: func() table.Table { return &unregisteredTable{} }},
+		{name: "Unregistered", csvFile: "Unregistered.csv", newTable: func() table.Table { return &table.ItemTable{} }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name+"/"+tc.csvFile, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected panic on duplicate registration")
+				}
+			}()
+			table.Register(tc.name, tc.csvFile, tc.newTable)
+		})
+	}
+}
+
+func TestRepositoryValidate(t *testing.T) {
+	dataPath, err := filepath.Abs("../../data")
+	if err != nil {
+		t.Fatalf("failed to get absolute path: %v", err)
+	}
+
+	tempDir := t.TempDir()
+	for _, reg := range table.Registrations() {
+		data, err := os.ReadFile(filepath.Join(dataPath, reg.CsvFile))
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", reg.CsvFile, err)
+		}
+
+		switch reg.CsvFile {
+		case "CreateCharacter.csv":
+			data = bytes.Replace(data, []byte("shoes,0.9"), []byte("shoes,1.5"), 1)
+		case "CreateCharacterCape.csv":
+			data = append(data, []byte("cape-not-exists,망토,1,1\n")...)
+		case "ClickerMonster.csv":
+			data = append(data, []byte("5,1,item-not-exists,1,10,m5\n")...)
+		}
+
+		if err := os.WriteFile(filepath.Join(tempDir, reg.CsvFile), data, 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", reg.CsvFile, err)
+		}
+	}
+
+	r := &table.Repository{}
+	if err := r.Load(tempDir); err != nil {
+		t.Fatalf("failed to load repository: %v", err)
+	}
+
+	err = r.Validate()
+	if !errors.Is(err, table.ErrTableValidation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+
+	for _, expected := range []string{
+		"CreateCharacter[shoes]: holding probability 1.5 is out of range [0,1]",
+		"CreateCharacterCape[cape-not-exists]: item not found in Item",
+		`ClickerMonster[5]: reward item "item-not-exists" not found in Item`,
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected validation error %q", expected)
+		}
+	}
+}
+
+func TestTableLoadErrors(t *testing.T) {
+	tempDir := t.TempDir()
+
+	write := func(name string, data string) string {
+		csvPath := filepath.Join(tempDir, name)
+		if err := os.WriteFile(csvPath, []byte(data), 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+		return csvPath
+	}
+
+	// 빈 정수 컬럼은 기본값을 사용합니다
+	clicker := table.NewClickerMonsterTable()
+	if err := clicker.Load(write("ClickerMonster.csv", "ModelID,Index,Prob,ItemIndex,ItemCount,Hp\nm1,1,10,,,5\n")); err != nil {
+		t.Fatalf("failed to load nullable columns: %v", err)
+	}
+	if rec, ok := clicker.Get("1"); !ok || rec.Hp != 5 || rec.ModelID != "m1" || rec.ItemCount != 0 {
+		t.Errorf("unexpected record mapped by header: %+v", rec)
+	}
+
+	// 목록 컬럼은 쉼표로 나누며 빈 항목은 제외합니다
+	weapon := table.NewCharacterWeaponTable()
+	weaponCsv := "Index,ItemName,WeaponType,Stand,Walk,SwingAttack,SwingFinalAttack,StabAttack,StabFinalAttack,ShootAttack,ShootFinalAttack,desc\n" +
+		"w-1,무기,none,stand1,walk1,\"swingO1, swingO2,\",,stabO1,,,,\n"
+	if err := weapon.Load(write("CharacterWeapon.csv", weaponCsv)); err != nil {
+		t.Fatalf("failed to load list columns: %v", err)
+	}
+	if rec, ok := weapon.Get("w-1"); !ok || !slices.Equal(rec.SwingAttack, []string{"swingO1", "swingO2"}) || rec.SwingFinalAttack != nil {
+		t.Errorf("unexpected list columns: %+v", rec)
+	}
+
+	// 열거형 컬럼은 허용된 값만 읽습니다
+	err := table.NewCreateCharacterTable().Load(write("CreateCharacter.csv", "Category,HoldingProb\nhair,1\nunknown,1\n"))
+	if !errors.Is(err, table.ErrInvalidEnum) {
+		t.Errorf("expected invalid enum error, got %v", err)
+	}
+
+	testCases := []struct {
+		name   string
+		data   string
+		err    error
+		row    int
+		column string
+	}{
+		{"duplicate key", "Index,Prob,ItemIndex,ItemCount,Hp,ModelID\n1,10,,,5,m1\n1,20,,,5,m2\n", table.ErrDuplicateKey, 3, "Index"},
+		{"missing column", "Index,Prob,ItemIndex,ItemCount,ModelID\n1,10,,,m1\n", table.ErrMissingColumn, 1, "Hp"},
+		{"column count", "Index,Prob,ItemIndex,ItemCount,Hp,ModelID\n1,10,,,5\n", table.ErrColumnCount, 2, ""},
+		{"empty value", "Index,Prob,ItemIndex,ItemCount,Hp,ModelID\n1,10,,,,m1\n", table.ErrEmptyValue, 2, "Hp"},
+		{"invalid number", "Index,Prob,ItemIndex,ItemCount,Hp,ModelID\n1,abc,,,5,m1\n", strconv.ErrSyntax, 2, "Prob"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := table.NewClickerMonsterTable().Load(write("ClickerMonster.csv", tc.data))
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected %v, got %v", tc.err, err)
+			}
+
+			var parseErr *table.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("expected parse error, got %v", err)
+			}
+			if parseErr.File != "ClickerMonster.csv" || parseErr.Row != tc.row || parseErr.Column != tc.column {
+				t.Errorf("unexpected error position: %v", parseErr)
+			}
+		})
+	}
+}
+
+func TestTableOrderAndIndexes(t *testing.T) {
+	csvPath := filepath.Join(t.TempDir(), "Item.csv")
+	data := "Index,Category,ItemName,ItemDesc,RUID,Icon,InventoryType\n" +
+		"hair-3,hair,c,,r,i,none\n" +
+		"cap-1,cap,a,,r,i,misc\n" +
+		"hair-1,hair,b,,r,i,none\n"
+	if err := os.WriteFile(csvPath, []byte(data), 0o644); err != nil {
+		t.Fatalf("failed to write Item.csv: %v", err)
+	}
+
+	items := table.NewItemTable()
+	if err := items.Load(csvPath); err != nil {
+		t.Fatalf("failed to load Item.csv: %v", err)
+	}
+
+	// 레코드는 항상 CSV 행 순서로 순회합니다
+	var indexes []string
+	for rec := range items.All() {
+		indexes = append(indexes, rec.Index)
+	}
+	if !slices.Equal(indexes, []string{"hair-3", "cap-1", "hair-1"}) || items.Len() != 3 {
+		t.Errorf("unexpected record order: %v", indexes)
+	}
+
+	hairs := items.GetByCategory(types.ItemCategory_Hair)
+	if len(hairs) != 2 || hairs[0].Index != "hair-3" || hairs[1].Index != "hair-1" {
+		t.Errorf("unexpected category index: %+v", hairs)
+	}
+	if misc := items.GetByInventoryType(types.InventoryType_Misc); len(misc) != 1 || misc[0].Index != "cap-1" {
+		t.Errorf("unexpected inventory type index: %+v", misc)
+	}
+}
@@ -0,0 +1,349 @@
+package table
+
+import (
+	"MScannot206/shared/def"
+	"MScannot206/shared/types"
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+var ErrTableValidation = errors.New("table validation failed")
+
+// 테이블 검증 오류
+type ValidationError struct {
+	// 테이블 이름
+	Table string
+
+	// 레코드 키
+	Key string
+
+	// 오류 내용
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Key == "" {
+		return fmt.Sprintf("%s: %s", e.Table, e.Message)
+	}
+	return fmt.Sprintf("%s[%s]: %s", e.Table, e.Key, e.Message)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrTableValidation
+}
+
+// 캐릭터 생성 장비 풀의 레코드
+type createCharacterPoolEntry struct {
+	Index      string
+	MaleProb   float64
+	FemaleProb float64
+}
+
+// 캐릭터 생성 장비 풀
+type createCharacterPool struct {
+	Table   string
+	Entries []createCharacterPoolEntry
+}
+
+func newCreateCharacterPool[R any](name string, records []R, entry func(R) createCharacterPoolEntry) createCharacterPool {
+	pool := createCharacterPool{
+		Table:   name,
+		Entries: make([]createCharacterPoolEntry, 0, len(records)),
+	}
+	for _, rec := range records {
+		pool.Entries = append(pool.Entries, entry(rec))
+	}
+	return pool
+}
+
+// 캐릭터 생성 카테고리별 장비 풀을 가져옵니다
+func (r *Repository) createCharacterPools() map[types.CharacterEquipType]createCharacterPool {
+	return map[types.CharacterEquipType]createCharacterPool{
+		types.CharacterEquipType_Hair: newCreateCharacterPool("CreateCharacterHair", get[*CreateCharacterHairTable](r).GetAll(), func(rec CreateCharacterHairRecord) createCharacterPoolEntry {
+			return createCharacterPoolEntry{rec.Index, rec.MaleProb, rec.FemaleProb}
+		}),
+		types.CharacterEquipType_Face: newCreateCharacterPool("CreateCharacterFace", get[*CreateCharacterFaceTable](r).GetAll(), func(rec CreateCharacterFaceRecord) createCharacterPoolEntry {
+			return createCharacterPoolEntry{rec.Index, rec.MaleProb, rec.FemaleProb}
+		}),
+		types.CharacterEquipType_Cap: newCreateCharacterPool("CreateCharacterCap", get[*CreateCharacterCapTable](r).GetAll(), func(rec CreateCharacterCapRecord) createCharacterPoolEntry {
+			return createCharacterPoolEntry{rec.Index, rec.MaleProb, rec.FemaleProb}
+		}),
+		types.CharacterEquipType_Cape: newCreateCharacterPool("CreateCharacterCape", get[*CreateCharacterCapeTable](r).GetAll(), func(rec CreateCharacterCapeRecord) createCharacterPoolEntry {
+			return createCharacterPoolEntry{rec.Index, rec.MaleProb, rec.FemaleProb}
+		}),
+		types.CharacterEquipType_Coat: newCreateCharacterPool("CreateCharacterCoat", get[*CreateCharacterCoatTable](r).GetAll(), func(rec CreateCharacterCoatRecord) createCharacterPoolEntry {
+			return createCharacterPoolEntry{rec.Index, rec.MaleProb, rec.FemaleProb}
+		}),
+		types.CharacterEquipType_Glove: newCreateCharacterPool("CreateCharacterGlove", get[*CreateCharacterGloveTable](r).GetAll(), func(rec CreateCharacterGloveRecord) createCharacterPoolEntry {
+			return createCharacterPoolEntry{rec.Index, rec.MaleProb, rec.FemaleProb}
+		}),
+		types.CharacterEquipType_LongCoat: newCreateCharacterPool("CreateCharacterLongCoat", get[*CreateCharacterLongCoatTable](r).GetAll(), func(rec CreateCharacterLongCoatRecord) createCharacterPoolEntry {
+			return createCharacterPoolEntry{rec.Index, rec.MaleProb, rec.FemaleProb}
+		}),
+		types.CharacterEquipType_Pants: newCreateCharacterPool("CreateCharacterPants", get[*CreateCharacterPantsTable](r).GetAll(), func(rec CreateCharacterPantsRecord) createCharacterPoolEntry {
+			return createCharacterPoolEntry{rec.Index, rec.MaleProb, rec.FemaleProb}
+		}),
+		types.CharacterEquipType_Shoes: newCreateCharacterPool("CreateCharacterShoes", get[*CreateCharacterShoesTable](r).GetAll(), func(rec CreateCharacterShoesRecord) createCharacterPoolEntry {
+			return createCharacterPoolEntry{rec.Index, rec.MaleProb, rec.FemaleProb}
+		}),
+		types.CharacterEquipType_FaceAccessory: newCreateCharacterPool("CreateCharacterFaceAcc", get[*CreateCharacterFaceAccTable](r).GetAll(), func(rec CreateCharacterFaceAccRecord) createCharacterPoolEntry {
+			return createCharacterPoolEntry{rec.Index, rec.MaleProb, rec.FemaleProb}
+		}),
+		types.CharacterEquipType_EyeAccessory: newCreateCharacterPool("CreateCharacterEysAcc", get[*CreateCharacterEysAccTable](r).GetAll(), func(rec CreateCharacterEysAccRecord) createCharacterPoolEntry {
+			return createCharacterPoolEntry{rec.Index, rec.MaleProb, rec.FemaleProb}
+		}),
+		types.CharacterEquipType_EarAccessory: newCreateCharacterPool("CreateCharacterEarAcc", get[*CreateCharacterEarAccTable](r).GetAll(), func(rec CreateCharacterEarAccRecord) createCharacterPoolEntry {
+			return createCharacterPoolEntry{rec.Index, rec.MaleProb, rec.FemaleProb}
+		}),
+		types.CharacterEquipType_1HWeapon: newCreateCharacterPool("CreateCharacter1HWeapon", get[*CreateCharacter1HWeaponTable](r).GetAll(), func(rec CreateCharacter1HWeaponRecord) createCharacterPoolEntry {
+			return createCharacterPoolEntry{rec.Index, rec.MaleProb, rec.FemaleProb}
+		}),
+		types.CharacterEquipType_2HWeapon: newCreateCharacterPool("CreateCharacter2HWeapon", get[*CreateCharacter2HWeaponTable](r).GetAll(), func(rec CreateCharacter2HWeaponRecord) createCharacterPoolEntry {
+			return createCharacterPoolEntry{rec.Index, rec.MaleProb, rec.FemaleProb}
+		}),
+		types.CharacterEquipType_SubWeapon: newCreateCharacterPool("CreateCharacterSubWeapon", get[*CreateCharacterSubWeaponTable](r).GetAll(), func(rec CreateCharacterSubWeaponRecord) createCharacterPoolEntry {
+			return createCharacterPoolEntry{rec.Index, rec.MaleProb, rec.FemaleProb}
+		}),
+		types.CharacterEquipType_Ear: newCreateCharacterPool("CreateCharacterEar", get[*CreateCharacterEarTable](r).GetAll(), func(rec CreateCharacterEarRecord) createCharacterPoolEntry {
+			return createCharacterPoolEntry{rec.Index, rec.MaleProb, rec.FemaleProb}
+		}),
+		types.CharacterEquipType_Skin: newCreateCharacterPool("CreateCharacterSkin", get[*CreateCharacterSkinTable](r).GetAll(), func(rec CreateCharacterSkinRecord) createCharacterPoolEntry {
+			return createCharacterPoolEntry{rec.Index, rec.MaleProb, rec.FemaleProb}
+		}),
+	}
+}
+
+// 테이블 간 참조 및 확률 데이터를 검증합니다
+// 댕글링 참조, ItemOption(없으면 CharacterEquipItem) 성별과 맞지 않는 확률, 가중치가 0인 풀, [0,1] 범위를 벗어난 확률을 모두 모아 하나의 오류로 반환합니다
+func (r *Repository) Validate() error {
+	if r == nil || r.tables == nil {
+		return ErrTableRepositoryIsNil
+	}
+
+	// 아래 검사는 등록된 모든 테이블이 불러와져 있다고 가정합니다
+	if err := r.checkRegistered(); err != nil {
+		return err
+	}
+
+	var errs []error
+	report := func(table string, key string, format string, args ...any) {
+		errs = append(errs, &ValidationError{
+			Table:   table,
+			Key:     key,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	items := get[*ItemTable](r)
+	itemOptions := get[*ItemOptionTable](r)
+	equipItems := get[*CharacterEquipItemTable](r)
+
+	// 아이템 옵션과 장착 아이템은 아이템 테이블을 참조해야 합니다
+	for _, rec := range itemOptions.GetAll() {
+		if _, ok := items.Get(rec.Index); !ok {
+			report("ItemOption", rec.Index, "item not found in Item")
+		}
+	}
+	for _, rec := range equipItems.GetAll() {
+		if _, ok := items.Get(rec.Index); !ok {
+			report("CharacterEquipItem", rec.Index, "item not found in Item")
+		}
+	}
+
+	// 캐릭터 생성 카테고리
+	pools := r.createCharacterPools()
+	for _, rec := range get[*CreateCharacterTable](r).GetAll() {
+		category := rec.Category
+		if rec.HoldingProb < 0 || rec.HoldingProb > 1 {
+			report("CreateCharacter", string(category), "holding probability %v is out of range [0,1]", rec.HoldingProb)
+		}
+
+		pool, ok := pools[category]
+		if !ok {
+			report("CreateCharacter", string(category), "no create character pool for category")
+			continue
+		}
+
+		// 획득 가능한 카테고리는 성별마다 가중치가 있는 레코드가 있어야 합니다
+		if rec.HoldingProb > 0 {
+			var maleWeight, femaleWeight float64
+			for _, entry := range pool.Entries {
+				maleWeight += max(entry.MaleProb, 0)
+				femaleWeight += max(entry.FemaleProb, 0)
+			}
+
+			if maleWeight == 0 {
+				report(pool.Table, "", "male pool has zero total weight")
+			}
+			if femaleWeight == 0 {
+				report(pool.Table, "", "female pool has zero total weight")
+			}
+		}
+	}
+
+	// 캐릭터 생성 장비 풀
+	for _, pool := range pools {
+		for _, entry := range pool.Entries {
+			if entry.MaleProb < 0 || entry.MaleProb > 1 {
+				report(pool.Table, entry.Index, "male probability %v is out of range [0,1]", entry.MaleProb)
+			}
+			if entry.FemaleProb < 0 || entry.FemaleProb > 1 {
+				report(pool.Table, entry.Index, "female probability %v is out of range [0,1]", entry.FemaleProb)
+			}
+
+			if _, ok := items.Get(entry.Index); !ok {
+				report(pool.Table, entry.Index, "item not found in Item")
+			}
+
+			// 성별 정보는 ItemOption을 우선하며 없는 경우 CharacterEquipItem을 사용합니다
+			var male, female bool
+			if option, ok := itemOptions.Get(entry.Index); ok {
+				male, female = option.Male, option.Female
+			} else if equipItem, ok := equipItems.Get(entry.Index); ok {
+				male, female = equipItem.Male, equipItem.Female
+			} else {
+				report(pool.Table, entry.Index, "item not found in ItemOption or CharacterEquipItem")
+				continue
+			}
+
+			if entry.MaleProb > 0 && !male {
+				report(pool.Table, entry.Index, "male probability is set but item does not allow male")
+			}
+			if entry.FemaleProb > 0 && !female {
+				report(pool.Table, entry.Index, "female probability is set but item does not allow female")
+			}
+		}
+	}
+
+	// 클리커 몬스터 등장 가중치와 처치 보상
+	var clickerWeight float64
+	for _, rec := range get[*ClickerMonsterTable](r).GetAll() {
+		if rec.ItemIndex != "" {
+			if _, ok := items.Get(rec.ItemIndex); !ok {
+				report("ClickerMonster", rec.Index, "reward item %q not found in Item", rec.ItemIndex)
+			}
+			if rec.ItemCount <= 0 {
+				report("ClickerMonster", rec.Index, "reward item count %v must be positive", rec.ItemCount)
+			}
+		}
+		if rec.Hp <= 0 {
+			report("ClickerMonster", rec.Index, "hp %v must be positive", rec.Hp)
+		}
+		if rec.Prob < 0 {
+			report("ClickerMonster", rec.Index, "negative weight %v", rec.Prob)
+			continue
+		}
+		clickerWeight += rec.Prob
+	}
+	if clickerWeight == 0 {
+		report("ClickerMonster", "", "pool has zero total weight")
+	}
+
+	// 보상 그룹
+	rewardGroups := get[*RewardGroupTable](r)
+	rewardEntries := get[*RewardGroupEntryTable](r)
+	for _, rec := range rewardEntries.GetAll() {
+		if _, ok := rewardGroups.Get(rec.GroupIndex); !ok {
+			report("RewardGroupEntry", rec.Index, "reward group %q not found", rec.GroupIndex)
+		}
+		if rec.ItemIndex == "" && rec.SubGroupIndex == "" {
+			report("RewardGroupEntry", rec.Index, "entry has neither item nor sub group")
+		}
+		if rec.ItemIndex != "" {
+			if _, ok := items.Get(rec.ItemIndex); !ok {
+				report("RewardGroupEntry", rec.Index, "item %q not found in Item", rec.ItemIndex)
+			}
+			if rec.ItemCount <= 0 {
+				report("RewardGroupEntry", rec.Index, "item count %v must be positive", rec.ItemCount)
+			}
+		}
+		if rec.SubGroupIndex != "" {
+			if _, ok := rewardGroups.Get(rec.SubGroupIndex); !ok {
+				report("RewardGroupEntry", rec.Index, "sub group %q not found", rec.SubGroupIndex)
+			}
+		}
+		if rec.Weight < 0 {
+			report("RewardGroupEntry", rec.Index, "negative weight %v", rec.Weight)
+		}
+	}
+	for _, rec := range rewardGroups.GetAll() {
+		var totalWeight, pityWeight float64
+		for _, entry := range rewardEntries.GetByGroupIndex(rec.Index) {
+			totalWeight += max(entry.Weight, 0)
+			if entry.Pity {
+				pityWeight += max(entry.Weight, 0)
+			}
+		}
+
+		if totalWeight == 0 {
+			report("RewardGroup", rec.Index, "pool has zero total weight")
+		}
+		if rec.PickType == types.RewardPickType_Independent && rec.MaxWeight <= 0 {
+			report("RewardGroup", rec.Index, "independent group requires positive max weight")
+		}
+		if rec.PityCount < 0 {
+			report("RewardGroup", rec.Index, "negative pity count %v", rec.PityCount)
+		}
+		if rec.PityCount > 0 && pityWeight == 0 {
+			report("RewardGroup", rec.Index, "pity count is set but group has no pity entry")
+		}
+		if r.hasRewardGroupCycle(rec.Index, rec.Index, map[string]bool{}) {
+			report("RewardGroup", rec.Index, "sub groups refer back to the group")
+		}
+	}
+
+	// 이름 필터
+	for _, rec := range get[*NameFilterTable](r).GetAll() {
+		if rec.Word == "" {
+			report("NameFilter", rec.Index, "word is empty")
+		}
+		switch def.Locale(rec.Locale) {
+		case "", def.LocaleKorean, def.LocaleEnglish, def.LocaleJapanese:
+		default:
+			report("NameFilter", rec.Index, "unknown locale %q", rec.Locale)
+		}
+	}
+
+	slices.SortFunc(errs, func(a, b error) int {
+		return cmp.Compare(a.Error(), b.Error())
+	})
+	return errors.Join(errs...)
+}
+
+// 레지스트리에 등록된 테이블이 모두 레포지토리에 있는지 확인합니다
+func (r *Repository) checkRegistered() error {
+	var errs error
+	for _, reg := range Registrations() {
+		if _, ok := r.tables[reflect.TypeOf(reg.New())]; !ok {
+			errs = errors.Join(errs, fmt.Errorf("%w: %s", ErrTableNotRegistered, reg.Name))
+		}
+	}
+	return errs
+}
+
+// 검증 중 테이블을 가져옵니다. checkRegistered로 등록된 테이블이 모두 있음을 확인한 뒤에만 사용합니다
+func get[T Table](r *Repository) T {
+	t, _ := Get[T](r)
+	return t
+}
+
+// 보상 그룹의 하위 그룹을 따라가며 target 그룹으로 되돌아오는지 확인합니다
+func (r *Repository) hasRewardGroupCycle(target string, groupIndex string, visited map[string]bool) bool {
+	if visited[groupIndex] {
+		return false
+	}
+	visited[groupIndex] = true
+
+	for _, entry := range get[*RewardGroupEntryTable](r).GetByGroupIndex(groupIndex) {
+		if entry.SubGroupIndex == "" {
+			continue
+		}
+		if entry.SubGroupIndex == target || r.hasRewardGroupCycle(target, entry.SubGroupIndex, visited) {
+			return true
+		}
+	}
+	return false
+}
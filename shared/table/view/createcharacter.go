@@ -3,47 +3,74 @@ package view
 import (
 	"MScannot206/shared/table"
 	"MScannot206/shared/types"
+	"MScannot206/shared/util"
+	"errors"
 	"maps"
 	"math/rand/v2"
 )
 
-func NewCreateCharacterView(
-	createCharacter table.CreateCharacterTable,
-	createCharacterHair table.CreateCharacterHairTable,
-	createCharacterFace table.CreateCharacterFaceTable,
-	createCharacterCap table.CreateCharacterCapTable,
-	createCharacterCape table.CreateCharacterCapeTable,
-	createCharacterCoat table.CreateCharacterCoatTable,
-	createCharacterGlove table.CreateCharacterGloveTable,
-	createCharacterLongCoat table.CreateCharacterLongCoatTable,
-	createCharacterPants table.CreateCharacterPantsTable,
-	createCharacterShoes table.CreateCharacterShoesTable,
-	createCharacterFaceAccessory table.CreateCharacterFaceAccTable,
-	createCharacterEyeAccessory table.CreateCharacterEysAccTable,
-	createCharacterEarAccessory table.CreateCharacterEarAccTable,
-	createCharacter1HWeapon table.CreateCharacter1HWeaponTable,
-	createCharacter2HWeapon table.CreateCharacter2HWeaponTable,
-	createCharacterSubWeapon table.CreateCharacterSubWeaponTable,
-	createCharacterEar table.CreateCharacterEarTable,
-	createCharacterSkin table.CreateCharacterSkinTable,
-) CreateCharacterView {
+// 캐릭터 생성 뷰를 만듭니다. 필요한 테이블이 레포지토리에 없으면 에러를 반환합니다
+func NewCreateCharacterView(tableRepo *table.Repository) (CreateCharacterView, error) {
+	var errs error
+	createCharacter := lookup[*table.CreateCharacterTable](tableRepo, &errs)
+	hair := lookup[*table.CreateCharacterHairTable](tableRepo, &errs)
+	face := lookup[*table.CreateCharacterFaceTable](tableRepo, &errs)
+	capTable := lookup[*table.CreateCharacterCapTable](tableRepo, &errs)
+	cape := lookup[*table.CreateCharacterCapeTable](tableRepo, &errs)
+	coat := lookup[*table.CreateCharacterCoatTable](tableRepo, &errs)
+	pants := lookup[*table.CreateCharacterPantsTable](tableRepo, &errs)
+	longCoat := lookup[*table.CreateCharacterLongCoatTable](tableRepo, &errs)
+	glove := lookup[*table.CreateCharacterGloveTable](tableRepo, &errs)
+	shoes := lookup[*table.CreateCharacterShoesTable](tableRepo, &errs)
+	faceAcc := lookup[*table.CreateCharacterFaceAccTable](tableRepo, &errs)
+	eyeAcc := lookup[*table.CreateCharacterEysAccTable](tableRepo, &errs)
+	earAcc := lookup[*table.CreateCharacterEarAccTable](tableRepo, &errs)
+	oneHandWeapon := lookup[*table.CreateCharacter1HWeaponTable](tableRepo, &errs)
+	twoHandWeapon := lookup[*table.CreateCharacter2HWeaponTable](tableRepo, &errs)
+	subWeapon := lookup[*table.CreateCharacterSubWeaponTable](tableRepo, &errs)
+	ear := lookup[*table.CreateCharacterEarTable](tableRepo, &errs)
+	skin := lookup[*table.CreateCharacterSkinTable](tableRepo, &errs)
+	if errs != nil {
+		return CreateCharacterView{}, errs
+	}
+
 	return CreateCharacterView{
-		HairView:          CreateCharacterHairTableView{&createCharacter, &createCharacterHair},
-		FaceView:          CreateCharacterFaceTableView{&createCharacter, &createCharacterFace},
-		CapView:           CreateCharacterCapTableView{&createCharacter, &createCharacterCap},
-		CapeView:          CreateCharacterCapeTableView{&createCharacter, &createCharacterCape},
-		CoatView:          CreateCharacterCoatTableView{&createCharacter, &createCharacterCoat, &createCharacterPants, &createCharacterLongCoat},
-		GloveView:         CreateCharacterGloveTableView{&createCharacter, &createCharacterGlove},
-		ShoesView:         CreateCharacterShoesTableView{&createCharacter, &createCharacterShoes},
-		FaceAccessoryView: CreateCharacterFaceAccTableView{&createCharacter, &createCharacterFaceAccessory},
-		EyeAccessoryView:  CreateCharacterEysAccTableView{&createCharacter, &createCharacterEyeAccessory},
-		EarAccessoryView:  CreateCharacterEarAccTableView{&createCharacter, &createCharacterEarAccessory},
-		WeaponView:        CreateCharacterWeaponTableView{&createCharacter, &createCharacter1HWeapon, &createCharacter2HWeapon, &createCharacterSubWeapon},
-		EarView:           CreateCharacterEarTableView{&createCharacter, &createCharacterEar},
-		SkinView:          CreateCharacterSkinTableView{&createCharacter, &createCharacterSkin},
+		HairView:          newCreateCharacterHairTableView(createCharacter, hair),
+		FaceView:          newCreateCharacterFaceTableView(createCharacter, face),
+		CapView:           newCreateCharacterCapTableView(createCharacter, capTable),
+		CapeView:          newCreateCharacterCapeTableView(createCharacter, cape),
+		CoatView:          newCreateCharacterCoatTableView(createCharacter, coat, pants, longCoat),
+		GloveView:         newCreateCharacterGloveTableView(createCharacter, glove),
+		ShoesView:         newCreateCharacterShoesTableView(createCharacter, shoes),
+		FaceAccessoryView: newCreateCharacterFaceAccTableView(createCharacter, faceAcc),
+		EyeAccessoryView:  newCreateCharacterEysAccTableView(createCharacter, eyeAcc),
+		EarAccessoryView:  newCreateCharacterEarAccTableView(createCharacter, earAcc),
+		WeaponView:        newCreateCharacterWeaponTableView(createCharacter, oneHandWeapon, twoHandWeapon, subWeapon),
+		EarView:           newCreateCharacterEarTableView(createCharacter, ear),
+		SkinView:          newCreateCharacterSkinTableView(createCharacter, skin),
+	}, nil
+}
+
+// 테이블을 가져오고 없으면 에러를 누적합니다
+func lookup[T table.Table](tableRepo *table.Repository, errs *error) T {
+	t, err := table.Lookup[T](tableRepo)
+	if err != nil {
+		*errs = errors.Join(*errs, err)
+	}
+	return t
+}
+
+// 테이블 레코드와 가중치로 성별 샘플러를 만듭니다
+func newRecordSampler[R any](records []R, weight func(R) float64) *util.AliasSampler[R] {
+	items := make([]util.Item[R, float64], 0, len(records))
+	for _, record := range records {
+		items = append(items, util.Item[R, float64]{Data: record, Weight: weight(record)})
 	}
+	return util.NewAliasSampler(items)
 }
 
+// 캐릭터 생성시 장비를 획득하기 위한 뷰입니다
+// 샘플러는 생성 시 한 번 만들어지고 변경되지 않으므로 여러 고루틴에서 공유해도 안전합니다 (테이블 리로드 시 새로 만듭니다)
 type CreateCharacterView struct {
 	HairView          CreateCharacterHairTableView
 	FaceView          CreateCharacterFaceTableView
@@ -175,3 +202,39 @@ func (v CreateCharacterView) GetFemale(rng *rand.Rand) map[types.CharacterEquipT
 
 	return ret
 }
+
+// 캐릭터 생성 시 직접 선택할 수 있는 외형 장비 종류인지 판단합니다
+func IsAppearanceType(equipType types.CharacterEquipType) bool {
+	switch equipType {
+	case types.CharacterEquipType_Hair, types.CharacterEquipType_Face, types.CharacterEquipType_Skin, types.CharacterEquipType_Ear:
+		return true
+	default:
+		return false
+	}
+}
+
+// 캐릭터 생성 시 성별에 맞게 선택할 수 있는 외형인지 판단합니다 (생성 테이블의 성별 확률이 0보다 커야 합니다)
+func (v CreateCharacterView) IsSelectableAppearance(gender int, equipType types.CharacterEquipType, index string) bool {
+	var isMaleSelectable, isFemaleSelectable func(string) bool
+	switch equipType {
+	case types.CharacterEquipType_Hair:
+		isMaleSelectable, isFemaleSelectable = v.HairView.IsMaleSelectable, v.HairView.IsFemaleSelectable
+	case types.CharacterEquipType_Face:
+		isMaleSelectable, isFemaleSelectable = v.FaceView.IsMaleSelectable, v.FaceView.IsFemaleSelectable
+	case types.CharacterEquipType_Skin:
+		isMaleSelectable, isFemaleSelectable = v.SkinView.IsMaleSelectable, v.SkinView.IsFemaleSelectable
+	case types.CharacterEquipType_Ear:
+		isMaleSelectable, isFemaleSelectable = v.EarView.IsMaleSelectable, v.EarView.IsFemaleSelectable
+	default:
+		return false
+	}
+
+	switch gender {
+	case types.GenderType_Male:
+		return isMaleSelectable(index)
+	case types.GenderType_Female:
+		return isFemaleSelectable(index)
+	default:
+		return false
+	}
+}
@@ -7,9 +7,23 @@ import (
 	"math/rand/v2"
 )
 
+func newCreateCharacterCapeTableView(createCharacter *table.CreateCharacterTable, createCharacterCape *table.CreateCharacterCapeTable) CreateCharacterCapeTableView {
+	v := CreateCharacterCapeTableView{
+		createCharacter:     createCharacter,
+		createCharacterCape: createCharacterCape,
+	}
+	v.maleSampler = newRecordSampler(v.GetMaleRecords(), func(record table.CreateCharacterCapeRecord) float64 { return record.MaleProb })
+	v.femaleSampler = newRecordSampler(v.GetFemaleRecords(), func(record table.CreateCharacterCapeRecord) float64 { return record.FemaleProb })
+	return v
+}
+
 type CreateCharacterCapeTableView struct {
 	createCharacter     *table.CreateCharacterTable
 	createCharacterCape *table.CreateCharacterCapeTable
+
+	// 테이블 로드 시 만들어 두는 성별 샘플러
+	maleSampler   *util.AliasSampler[table.CreateCharacterCapeRecord]
+	femaleSampler *util.AliasSampler[table.CreateCharacterCapeRecord]
 }
 
 // 캐릭터 모자를 획득 할 수 있는지 확률을 통해 판단합니다
@@ -18,7 +32,7 @@ func (v CreateCharacterCapeTableView) isHolding(rng *rand.Rand) bool {
 		return false
 	}
 
-	ccRecord, ok := v.createCharacter.Get(string(types.CharacterEquipType_Cap))
+	ccRecord, ok := v.createCharacter.Get(types.CharacterEquipType_Cap)
 	if !ok {
 		return false
 	}
@@ -36,13 +50,8 @@ func (v CreateCharacterCapeTableView) GetMale(rng *rand.Rand) (string, bool) {
 		return "", false
 	}
 
-	weightedPicker := util.NewWeightedPicker[table.CreateCharacterCapeRecord, float64](rng)
-	for _, record := range v.GetMaleRecords() {
-		weightedPicker.Add(record, record.MaleProb)
-	}
-
-	pickedRecord, err := weightedPicker.Pick()
-	if err != nil {
+	pickedRecord, ok := v.maleSampler.Pick(rng)
+	if !ok {
 		return "", false
 	}
 
@@ -55,13 +64,8 @@ func (v CreateCharacterCapeTableView) GetFemale(rng *rand.Rand) (string, bool) {
 		return "", false
 	}
 
-	weightedPicker := util.NewWeightedPicker[table.CreateCharacterCapeRecord, float64](rng)
-	for _, record := range v.GetFemaleRecords() {
-		weightedPicker.Add(record, record.FemaleProb)
-	}
-
-	pickedRecord, err := weightedPicker.Pick()
-	if err != nil {
+	pickedRecord, ok := v.femaleSampler.Pick(rng)
+	if !ok {
 		return "", false
 	}
 
@@ -74,15 +78,7 @@ func (v CreateCharacterCapeTableView) GetMaleRecords() []table.CreateCharacterCa
 		return []table.CreateCharacterCapeRecord{}
 	}
 
-	records := v.createCharacterCape.GetAll()
-	ret := make([]table.CreateCharacterCapeRecord, 0, len(records))
-	for _, record := range records {
-		if record.MaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-
-	return ret
+	return v.createCharacterCape.GetMaleRecords()
 }
 
 // 여성 캐릭터 모자 레코드를 모두 가져옵니다
@@ -91,14 +87,5 @@ func (v CreateCharacterCapeTableView) GetFemaleRecords() []table.CreateCharacter
 		return []table.CreateCharacterCapeRecord{}
 	}
 
-	records := v.createCharacterCape.GetAll()
-	ret := make([]table.CreateCharacterCapeRecord, 0, len(records))
-
-	for _, record := range records {
-		if record.FemaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-
-	return ret
+	return v.createCharacterCape.GetFemaleRecords()
 }
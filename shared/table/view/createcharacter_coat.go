@@ -7,12 +7,36 @@ import (
 	"math/rand/v2"
 )
 
+func newCreateCharacterCoatTableView(createCharacter *table.CreateCharacterTable, createCharacterCoat *table.CreateCharacterCoatTable, createCharacterPants *table.CreateCharacterPantsTable, createCharacterLongCoat *table.CreateCharacterLongCoatTable) CreateCharacterCoatTableView {
+	v := CreateCharacterCoatTableView{
+		createCharacter:         createCharacter,
+		createCharacterCoat:     createCharacterCoat,
+		createCharacterPants:    createCharacterPants,
+		createCharacterLongCoat: createCharacterLongCoat,
+	}
+	v.maleCoatSampler = newRecordSampler(v.GetMaleCoatRecords(), func(record table.CreateCharacterCoatRecord) float64 { return record.MaleProb })
+	v.femaleCoatSampler = newRecordSampler(v.GetFemaleCoatRecords(), func(record table.CreateCharacterCoatRecord) float64 { return record.FemaleProb })
+	v.malePantsSampler = newRecordSampler(v.GetMalePantsRecords(), func(record table.CreateCharacterPantsRecord) float64 { return record.MaleProb })
+	v.femalePantsSampler = newRecordSampler(v.GetFemalePantsRecords(), func(record table.CreateCharacterPantsRecord) float64 { return record.FemaleProb })
+	v.maleLongCoatSampler = newRecordSampler(v.GetMaleLongCoatRecords(), func(record table.CreateCharacterLongCoatRecord) float64 { return record.MaleProb })
+	v.femaleLongCoatSampler = newRecordSampler(v.GetFemaleLongCoatRecords(), func(record table.CreateCharacterLongCoatRecord) float64 { return record.FemaleProb })
+	return v
+}
+
 // 캐릭터 생성시 상의/하의/한벌옷을 획득하기 위한 뷰입니다
 type CreateCharacterCoatTableView struct {
 	createCharacter         *table.CreateCharacterTable
 	createCharacterCoat     *table.CreateCharacterCoatTable
 	createCharacterPants    *table.CreateCharacterPantsTable
 	createCharacterLongCoat *table.CreateCharacterLongCoatTable
+
+	// 테이블 로드 시 만들어 두는 성별 샘플러
+	maleCoatSampler       *util.AliasSampler[table.CreateCharacterCoatRecord]
+	femaleCoatSampler     *util.AliasSampler[table.CreateCharacterCoatRecord]
+	malePantsSampler      *util.AliasSampler[table.CreateCharacterPantsRecord]
+	femalePantsSampler    *util.AliasSampler[table.CreateCharacterPantsRecord]
+	maleLongCoatSampler   *util.AliasSampler[table.CreateCharacterLongCoatRecord]
+	femaleLongCoatSampler *util.AliasSampler[table.CreateCharacterLongCoatRecord]
 }
 
 // 캐릭터 의상을 획득 할 수 있는지 확률을 통해 판단합니다
@@ -26,7 +50,7 @@ func (v CreateCharacterCoatTableView) isHolding(rng *rand.Rand) ([]types.Charact
 	var isHoldingLongCoat bool // 2HWeapon 대응: LongCoat
 
 	// 한벌옷(LongCoat)을 먼저 판단합니다. 한벌옷을 획득한 경우 상의(Coat)와 하의(Pants)를 획득할 수 없습니다
-	if longCoatRecord, ok := v.createCharacter.Get(string(types.CharacterEquipType_LongCoat)); ok {
+	if longCoatRecord, ok := v.createCharacter.Get(types.CharacterEquipType_LongCoat); ok {
 		singleProb.Set(types.CharacterEquipType_LongCoat, longCoatRecord.HoldingProb)
 		if _, ok := singleProb.Pick(); ok {
 			ret = append(ret, types.CharacterEquipType_LongCoat)
@@ -37,7 +61,7 @@ func (v CreateCharacterCoatTableView) isHolding(rng *rand.Rand) ([]types.Charact
 	// 한벌옷을 획득하지 못한 경우 상의와 하의를 획득할 수 있습니다
 	if !isHoldingLongCoat {
 		// Coat (1HWeapon 대응)
-		if coatRecord, ok := v.createCharacter.Get(string(types.CharacterEquipType_Coat)); ok {
+		if coatRecord, ok := v.createCharacter.Get(types.CharacterEquipType_Coat); ok {
 			singleProb.Set(types.CharacterEquipType_Coat, coatRecord.HoldingProb)
 			if _, ok := singleProb.Pick(); ok {
 				ret = append(ret, types.CharacterEquipType_Coat)
@@ -45,7 +69,7 @@ func (v CreateCharacterCoatTableView) isHolding(rng *rand.Rand) ([]types.Charact
 		}
 
 		// Pants (SubWeapon 대응)
-		if pantsRecord, ok := v.createCharacter.Get(string(types.CharacterEquipType_Pants)); ok {
+		if pantsRecord, ok := v.createCharacter.Get(types.CharacterEquipType_Pants); ok {
 			singleProb.Set(types.CharacterEquipType_Pants, pantsRecord.HoldingProb)
 			if _, ok := singleProb.Pick(); ok {
 				ret = append(ret, types.CharacterEquipType_Pants)
@@ -68,35 +92,20 @@ func (v CreateCharacterCoatTableView) GetMale(rng *rand.Rand) (map[types.Charact
 	for _, t := range holdingTypes {
 		switch t {
 		case types.CharacterEquipType_LongCoat:
-			weightedPicker := util.NewWeightedPicker[table.CreateCharacterLongCoatRecord, float64](rng)
-			for _, record := range v.GetMaleLongCoatRecords() {
-				weightedPicker.Add(record, record.MaleProb)
-			}
-
-			pickedRecord, err := weightedPicker.Pick()
-			if err == nil {
+			pickedRecord, ok := v.maleLongCoatSampler.Pick(rng)
+			if ok {
 				ret[types.CharacterEquipType_LongCoat] = pickedRecord.Index
 			}
 
 		case types.CharacterEquipType_Coat:
-			weightedPicker := util.NewWeightedPicker[table.CreateCharacterCoatRecord, float64](rng)
-			for _, record := range v.GetMaleCoatRecords() {
-				weightedPicker.Add(record, record.MaleProb)
-			}
-
-			pickedRecord, err := weightedPicker.Pick()
-			if err == nil {
+			pickedRecord, ok := v.maleCoatSampler.Pick(rng)
+			if ok {
 				ret[types.CharacterEquipType_Coat] = pickedRecord.Index
 			}
 
 		case types.CharacterEquipType_Pants:
-			weightedPicker := util.NewWeightedPicker[table.CreateCharacterPantsRecord, float64](rng)
-			for _, record := range v.GetMalePantsRecords() {
-				weightedPicker.Add(record, record.MaleProb)
-			}
-
-			pickedRecord, err := weightedPicker.Pick()
-			if err == nil {
+			pickedRecord, ok := v.malePantsSampler.Pick(rng)
+			if ok {
 				ret[types.CharacterEquipType_Pants] = pickedRecord.Index
 			}
 		}
@@ -117,35 +126,20 @@ func (v CreateCharacterCoatTableView) GetFemale(rng *rand.Rand) (map[types.Chara
 	for _, t := range holdingTypes {
 		switch t {
 		case types.CharacterEquipType_LongCoat:
-			weightedPicker := util.NewWeightedPicker[table.CreateCharacterLongCoatRecord, float64](rng)
-			for _, record := range v.GetFemaleLongCoatRecords() {
-				weightedPicker.Add(record, record.FemaleProb)
-			}
-
-			pickedRecord, err := weightedPicker.Pick()
-			if err == nil {
+			pickedRecord, ok := v.femaleLongCoatSampler.Pick(rng)
+			if ok {
 				ret[types.CharacterEquipType_LongCoat] = pickedRecord.Index
 			}
 
 		case types.CharacterEquipType_Coat:
-			weightedPicker := util.NewWeightedPicker[table.CreateCharacterCoatRecord, float64](rng)
-			for _, record := range v.GetFemaleCoatRecords() {
-				weightedPicker.Add(record, record.FemaleProb)
-			}
-
-			pickedRecord, err := weightedPicker.Pick()
-			if err == nil {
+			pickedRecord, ok := v.femaleCoatSampler.Pick(rng)
+			if ok {
 				ret[types.CharacterEquipType_Coat] = pickedRecord.Index
 			}
 
 		case types.CharacterEquipType_Pants:
-			weightedPicker := util.NewWeightedPicker[table.CreateCharacterPantsRecord, float64](rng)
-			for _, record := range v.GetFemalePantsRecords() {
-				weightedPicker.Add(record, record.FemaleProb)
-			}
-
-			pickedRecord, err := weightedPicker.Pick()
-			if err == nil {
+			pickedRecord, ok := v.femalePantsSampler.Pick(rng)
+			if ok {
 				ret[types.CharacterEquipType_Pants] = pickedRecord.Index
 			}
 		}
@@ -160,14 +154,7 @@ func (v CreateCharacterCoatTableView) GetMaleCoatRecords() []table.CreateCharact
 		return []table.CreateCharacterCoatRecord{}
 	}
 
-	records := v.createCharacterCoat.GetAll()
-	ret := make([]table.CreateCharacterCoatRecord, 0, len(records))
-	for _, record := range records {
-		if record.MaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-	return ret
+	return v.createCharacterCoat.GetMaleRecords()
 }
 
 // 여성 캐릭터 상의 레코드를 모두 가져옵니다
@@ -176,14 +163,7 @@ func (v CreateCharacterCoatTableView) GetFemaleCoatRecords() []table.CreateChara
 		return []table.CreateCharacterCoatRecord{}
 	}
 
-	records := v.createCharacterCoat.GetAll()
-	ret := make([]table.CreateCharacterCoatRecord, 0, len(records))
-	for _, record := range records {
-		if record.FemaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-	return ret
+	return v.createCharacterCoat.GetFemaleRecords()
 }
 
 // 남성 캐릭터 하의 레코드를 모두 가져옵니다
@@ -192,14 +172,7 @@ func (v CreateCharacterCoatTableView) GetMalePantsRecords() []table.CreateCharac
 		return []table.CreateCharacterPantsRecord{}
 	}
 
-	records := v.createCharacterPants.GetAll()
-	ret := make([]table.CreateCharacterPantsRecord, 0, len(records))
-	for _, record := range records {
-		if record.MaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-	return ret
+	return v.createCharacterPants.GetMaleRecords()
 }
 
 // 여성 캐릭터 하의 레코드를 모두 가져옵니다
@@ -208,14 +181,7 @@ func (v CreateCharacterCoatTableView) GetFemalePantsRecords() []table.CreateChar
 		return []table.CreateCharacterPantsRecord{}
 	}
 
-	records := v.createCharacterPants.GetAll()
-	ret := make([]table.CreateCharacterPantsRecord, 0, len(records))
-	for _, record := range records {
-		if record.FemaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-	return ret
+	return v.createCharacterPants.GetFemaleRecords()
 }
 
 // 남성 캐릭터 한벌옷 레코드를 모두 가져옵니다
@@ -224,14 +190,7 @@ func (v CreateCharacterCoatTableView) GetMaleLongCoatRecords() []table.CreateCha
 		return []table.CreateCharacterLongCoatRecord{}
 	}
 
-	records := v.createCharacterLongCoat.GetAll()
-	ret := make([]table.CreateCharacterLongCoatRecord, 0, len(records))
-	for _, record := range records {
-		if record.MaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-	return ret
+	return v.createCharacterLongCoat.GetMaleRecords()
 }
 
 // 여성 캐릭터 한벌옷 레코드를 모두 가져옵니다
@@ -240,12 +199,5 @@ func (v CreateCharacterCoatTableView) GetFemaleLongCoatRecords() []table.CreateC
 		return []table.CreateCharacterLongCoatRecord{}
 	}
 
-	records := v.createCharacterLongCoat.GetAll()
-	ret := make([]table.CreateCharacterLongCoatRecord, 0, len(records))
-	for _, record := range records {
-		if record.FemaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-	return ret
+	return v.createCharacterLongCoat.GetFemaleRecords()
 }
@@ -7,10 +7,24 @@ import (
 	"math/rand/v2"
 )
 
+func newCreateCharacterEarTableView(createCharacter *table.CreateCharacterTable, createCharacterEar *table.CreateCharacterEarTable) CreateCharacterEarTableView {
+	v := CreateCharacterEarTableView{
+		createCharacter:    createCharacter,
+		createCharacterEar: createCharacterEar,
+	}
+	v.maleSampler = newRecordSampler(v.GetMaleRecords(), func(record table.CreateCharacterEarRecord) float64 { return record.MaleProb })
+	v.femaleSampler = newRecordSampler(v.GetFemaleRecords(), func(record table.CreateCharacterEarRecord) float64 { return record.FemaleProb })
+	return v
+}
+
 // 캐릭터 생성시 귀 모양을 획득하기 위한 뷰입니다
 type CreateCharacterEarTableView struct {
 	createCharacter    *table.CreateCharacterTable
 	createCharacterEar *table.CreateCharacterEarTable
+
+	// 테이블 로드 시 만들어 두는 성별 샘플러
+	maleSampler   *util.AliasSampler[table.CreateCharacterEarRecord]
+	femaleSampler *util.AliasSampler[table.CreateCharacterEarRecord]
 }
 
 // 캐릭터 귀 모양을 획득 할 수 있는지 확률을 통해 판단합니다
@@ -19,7 +33,7 @@ func (v CreateCharacterEarTableView) isHolding(rng *rand.Rand) bool {
 		return false
 	}
 
-	ccRecord, ok := v.createCharacter.Get(string(types.CharacterEquipType_Ear))
+	ccRecord, ok := v.createCharacter.Get(types.CharacterEquipType_Ear)
 	if !ok {
 		return false
 	}
@@ -37,13 +51,8 @@ func (v CreateCharacterEarTableView) GetMale(rng *rand.Rand) (string, bool) {
 		return "", false
 	}
 
-	weightedPicker := util.NewWeightedPicker[table.CreateCharacterEarRecord, float64](rng)
-	for _, record := range v.GetMaleRecords() {
-		weightedPicker.Add(record, record.MaleProb)
-	}
-
-	pickedRecord, err := weightedPicker.Pick()
-	if err != nil {
+	pickedRecord, ok := v.maleSampler.Pick(rng)
+	if !ok {
 		return "", false
 	}
 
@@ -56,13 +65,8 @@ func (v CreateCharacterEarTableView) GetFemale(rng *rand.Rand) (string, bool) {
 		return "", false
 	}
 
-	weightedPicker := util.NewWeightedPicker[table.CreateCharacterEarRecord, float64](rng)
-	for _, record := range v.GetFemaleRecords() {
-		weightedPicker.Add(record, record.FemaleProb)
-	}
-
-	pickedRecord, err := weightedPicker.Pick()
-	if err != nil {
+	pickedRecord, ok := v.femaleSampler.Pick(rng)
+	if !ok {
 		return "", false
 	}
 
@@ -75,15 +79,7 @@ func (v CreateCharacterEarTableView) GetMaleRecords() []table.CreateCharacterEar
 		return []table.CreateCharacterEarRecord{}
 	}
 
-	records := v.createCharacterEar.GetAll()
-	ret := make([]table.CreateCharacterEarRecord, 0, len(records))
-	for _, record := range records {
-		if record.MaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-
-	return ret
+	return v.createCharacterEar.GetMaleRecords()
 }
 
 // 여성 캐릭터 귀 모양 레코드를 모두 가져옵니다
@@ -92,13 +88,25 @@ func (v CreateCharacterEarTableView) GetFemaleRecords() []table.CreateCharacterE
 		return []table.CreateCharacterEarRecord{}
 	}
 
-	records := v.createCharacterEar.GetAll()
-	ret := make([]table.CreateCharacterEarRecord, 0, len(records))
-	for _, record := range records {
-		if record.FemaleProb > 0 {
-			ret = append(ret, record)
-		}
+	return v.createCharacterEar.GetFemaleRecords()
+}
+
+// 남성 캐릭터가 생성 시 선택할 수 있는 귀 모양인지 판단합니다
+func (v CreateCharacterEarTableView) IsMaleSelectable(index string) bool {
+	if v.createCharacterEar == nil {
+		return false
+	}
+
+	record, ok := v.createCharacterEar.Get(index)
+	return ok && record.MaleProb > 0
+}
+
+// 여성 캐릭터가 생성 시 선택할 수 있는 귀 모양인지 판단합니다
+func (v CreateCharacterEarTableView) IsFemaleSelectable(index string) bool {
+	if v.createCharacterEar == nil {
+		return false
 	}
 
-	return ret
+	record, ok := v.createCharacterEar.Get(index)
+	return ok && record.FemaleProb > 0
 }
@@ -7,10 +7,24 @@ import (
 	"math/rand/v2"
 )
 
+func newCreateCharacterFaceTableView(createCharacter *table.CreateCharacterTable, createCharacterFace *table.CreateCharacterFaceTable) CreateCharacterFaceTableView {
+	v := CreateCharacterFaceTableView{
+		CreateCharacter:     createCharacter,
+		CreateCharacterFace: createCharacterFace,
+	}
+	v.maleSampler = newRecordSampler(v.GetMaleRecords(), func(record table.CreateCharacterFaceRecord) float64 { return record.MaleProb })
+	v.femaleSampler = newRecordSampler(v.GetFemaleRecords(), func(record table.CreateCharacterFaceRecord) float64 { return record.FemaleProb })
+	return v
+}
+
 // 캐릭터 생성시 얼굴을 획득하기 위한 뷰입니다
 type CreateCharacterFaceTableView struct {
 	CreateCharacter     *table.CreateCharacterTable
 	CreateCharacterFace *table.CreateCharacterFaceTable
+
+	// 테이블 로드 시 만들어 두는 성별 샘플러
+	maleSampler   *util.AliasSampler[table.CreateCharacterFaceRecord]
+	femaleSampler *util.AliasSampler[table.CreateCharacterFaceRecord]
 }
 
 // 캐릭터 얼굴을 획득 할 수 있는지 확률을 통해 판단합니다
@@ -19,7 +33,7 @@ func (v CreateCharacterFaceTableView) isHolding(rng *rand.Rand) bool {
 		return false
 	}
 
-	ccRecord, ok := v.CreateCharacter.Get(string(types.CharacterEquipType_Face))
+	ccRecord, ok := v.CreateCharacter.Get(types.CharacterEquipType_Face)
 	if !ok {
 		return false
 	}
@@ -37,13 +51,8 @@ func (v CreateCharacterFaceTableView) GetMale(rng *rand.Rand) (string, bool) {
 		return "", false
 	}
 
-	weightedPicker := util.NewWeightedPicker[table.CreateCharacterFaceRecord, float64](rng)
-	for _, record := range v.GetMaleRecords() {
-		weightedPicker.Add(record, record.MaleProb)
-	}
-
-	pickedRecord, err := weightedPicker.Pick()
-	if err != nil {
+	pickedRecord, ok := v.maleSampler.Pick(rng)
+	if !ok {
 		return "", false
 	}
 
@@ -56,13 +65,8 @@ func (v CreateCharacterFaceTableView) GetFemale(rng *rand.Rand) (string, bool) {
 		return "", false
 	}
 
-	weightedPicker := util.NewWeightedPicker[table.CreateCharacterFaceRecord, float64](rng)
-	for _, record := range v.GetFemaleRecords() {
-		weightedPicker.Add(record, record.FemaleProb)
-	}
-
-	pickedRecord, err := weightedPicker.Pick()
-	if err != nil {
+	pickedRecord, ok := v.femaleSampler.Pick(rng)
+	if !ok {
 		return "", false
 	}
 
@@ -75,15 +79,7 @@ func (v CreateCharacterFaceTableView) GetMaleRecords() []table.CreateCharacterFa
 		return []table.CreateCharacterFaceRecord{}
 	}
 
-	records := v.CreateCharacterFace.GetAll()
-	ret := make([]table.CreateCharacterFaceRecord, 0, len(records))
-	for _, record := range records {
-		if record.MaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-
-	return ret
+	return v.CreateCharacterFace.GetMaleRecords()
 }
 
 // 여성 캐릭터 얼굴 레코드를 모두 가져옵니다
@@ -92,13 +88,25 @@ func (v CreateCharacterFaceTableView) GetFemaleRecords() []table.CreateCharacter
 		return []table.CreateCharacterFaceRecord{}
 	}
 
-	records := v.CreateCharacterFace.GetAll()
-	ret := make([]table.CreateCharacterFaceRecord, 0, len(records))
-	for _, record := range records {
-		if record.FemaleProb > 0 {
-			ret = append(ret, record)
-		}
+	return v.CreateCharacterFace.GetFemaleRecords()
+}
+
+// 남성 캐릭터가 생성 시 선택할 수 있는 얼굴인지 판단합니다
+func (v CreateCharacterFaceTableView) IsMaleSelectable(index string) bool {
+	if v.CreateCharacterFace == nil {
+		return false
+	}
+
+	record, ok := v.CreateCharacterFace.Get(index)
+	return ok && record.MaleProb > 0
+}
+
+// 여성 캐릭터가 생성 시 선택할 수 있는 얼굴인지 판단합니다
+func (v CreateCharacterFaceTableView) IsFemaleSelectable(index string) bool {
+	if v.CreateCharacterFace == nil {
+		return false
 	}
 
-	return ret
+	record, ok := v.CreateCharacterFace.Get(index)
+	return ok && record.FemaleProb > 0
 }
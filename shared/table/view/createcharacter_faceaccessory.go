@@ -7,10 +7,24 @@ import (
 	"math/rand/v2"
 )
 
+func newCreateCharacterFaceAccTableView(createCharacter *table.CreateCharacterTable, createCharacterFaceAcc *table.CreateCharacterFaceAccTable) CreateCharacterFaceAccTableView {
+	v := CreateCharacterFaceAccTableView{
+		createCharacter:        createCharacter,
+		createCharacterFaceAcc: createCharacterFaceAcc,
+	}
+	v.maleSampler = newRecordSampler(v.GetMaleRecords(), func(record table.CreateCharacterFaceAccRecord) float64 { return record.MaleProb })
+	v.femaleSampler = newRecordSampler(v.GetFemaleRecords(), func(record table.CreateCharacterFaceAccRecord) float64 { return record.FemaleProb })
+	return v
+}
+
 // 캐릭터 생성시 얼굴 장식을 획득하기 위한 뷰입니다
 type CreateCharacterFaceAccTableView struct {
 	createCharacter        *table.CreateCharacterTable
 	createCharacterFaceAcc *table.CreateCharacterFaceAccTable
+
+	// 테이블 로드 시 만들어 두는 성별 샘플러
+	maleSampler   *util.AliasSampler[table.CreateCharacterFaceAccRecord]
+	femaleSampler *util.AliasSampler[table.CreateCharacterFaceAccRecord]
 }
 
 // 캐릭터 얼굴 장식을 획득 할 수 있는지 확률을 통해 판단합니다
@@ -19,7 +33,7 @@ func (v CreateCharacterFaceAccTableView) isHolding(rng *rand.Rand) bool {
 		return false
 	}
 
-	ccRecord, ok := v.createCharacter.Get(string(types.CharacterEquipType_FaceAccessory))
+	ccRecord, ok := v.createCharacter.Get(types.CharacterEquipType_FaceAccessory)
 	if !ok {
 		return false
 	}
@@ -37,13 +51,8 @@ func (v CreateCharacterFaceAccTableView) GetMale(rng *rand.Rand) (string, bool)
 		return "", false
 	}
 
-	weightedPicker := util.NewWeightedPicker[table.CreateCharacterFaceAccRecord, float64](rng)
-	for _, record := range v.GetMaleRecords() {
-		weightedPicker.Add(record, record.MaleProb)
-	}
-
-	pickedRecord, err := weightedPicker.Pick()
-	if err != nil {
+	pickedRecord, ok := v.maleSampler.Pick(rng)
+	if !ok {
 		return "", false
 	}
 
@@ -56,13 +65,8 @@ func (v CreateCharacterFaceAccTableView) GetFemale(rng *rand.Rand) (string, bool
 		return "", false
 	}
 
-	weightedPicker := util.NewWeightedPicker[table.CreateCharacterFaceAccRecord, float64](rng)
-	for _, record := range v.GetFemaleRecords() {
-		weightedPicker.Add(record, record.FemaleProb)
-	}
-
-	pickedRecord, err := weightedPicker.Pick()
-	if err != nil {
+	pickedRecord, ok := v.femaleSampler.Pick(rng)
+	if !ok {
 		return "", false
 	}
 
@@ -75,15 +79,7 @@ func (v CreateCharacterFaceAccTableView) GetMaleRecords() []table.CreateCharacte
 		return []table.CreateCharacterFaceAccRecord{}
 	}
 
-	records := v.createCharacterFaceAcc.GetAll()
-	ret := make([]table.CreateCharacterFaceAccRecord, 0, len(records))
-	for _, record := range records {
-		if record.MaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-
-	return ret
+	return v.createCharacterFaceAcc.GetMaleRecords()
 }
 
 // 여성 캐릭터 얼굴 장식 레코드를 모두 가져옵니다
@@ -92,13 +88,5 @@ func (v CreateCharacterFaceAccTableView) GetFemaleRecords() []table.CreateCharac
 		return []table.CreateCharacterFaceAccRecord{}
 	}
 
-	records := v.createCharacterFaceAcc.GetAll()
-	ret := make([]table.CreateCharacterFaceAccRecord, 0, len(records))
-	for _, record := range records {
-		if record.FemaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-
-	return ret
+	return v.createCharacterFaceAcc.GetFemaleRecords()
 }
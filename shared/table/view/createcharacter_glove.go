@@ -7,10 +7,24 @@ import (
 	"math/rand/v2"
 )
 
+func newCreateCharacterGloveTableView(createCharacter *table.CreateCharacterTable, createCharacterGlove *table.CreateCharacterGloveTable) CreateCharacterGloveTableView {
+	v := CreateCharacterGloveTableView{
+		createCharacter:      createCharacter,
+		createCharacterGlove: createCharacterGlove,
+	}
+	v.maleSampler = newRecordSampler(v.GetMaleRecords(), func(record table.CreateCharacterGloveRecord) float64 { return record.MaleProb })
+	v.femaleSampler = newRecordSampler(v.GetFemaleRecords(), func(record table.CreateCharacterGloveRecord) float64 { return record.FemaleProb })
+	return v
+}
+
 // 캐릭터 생성시 장갑을 획득하기 위한 뷰입니다
 type CreateCharacterGloveTableView struct {
 	createCharacter      *table.CreateCharacterTable
 	createCharacterGlove *table.CreateCharacterGloveTable
+
+	// 테이블 로드 시 만들어 두는 성별 샘플러
+	maleSampler   *util.AliasSampler[table.CreateCharacterGloveRecord]
+	femaleSampler *util.AliasSampler[table.CreateCharacterGloveRecord]
 }
 
 // 캐릭터 장갑을 획득 할 수 있는지 확률을 통해 판단합니다
@@ -19,7 +33,7 @@ func (v CreateCharacterGloveTableView) isHolding(rng *rand.Rand) bool {
 		return false
 	}
 
-	ccRecord, ok := v.createCharacter.Get(string(types.CharacterEquipType_Glove))
+	ccRecord, ok := v.createCharacter.Get(types.CharacterEquipType_Glove)
 	if !ok {
 		return false
 	}
@@ -37,13 +51,8 @@ func (v CreateCharacterGloveTableView) GetMale(rng *rand.Rand) (string, bool) {
 		return "", false
 	}
 
-	weightedPicker := util.NewWeightedPicker[table.CreateCharacterGloveRecord, float64](rng)
-	for _, record := range v.GetMaleRecords() {
-		weightedPicker.Add(record, record.MaleProb)
-	}
-
-	pickedRecord, err := weightedPicker.Pick()
-	if err != nil {
+	pickedRecord, ok := v.maleSampler.Pick(rng)
+	if !ok {
 		return "", false
 	}
 
@@ -56,13 +65,8 @@ func (v CreateCharacterGloveTableView) GetFemale(rng *rand.Rand) (string, bool)
 		return "", false
 	}
 
-	weightedPicker := util.NewWeightedPicker[table.CreateCharacterGloveRecord, float64](rng)
-	for _, record := range v.GetFemaleRecords() {
-		weightedPicker.Add(record, record.FemaleProb)
-	}
-
-	pickedRecord, err := weightedPicker.Pick()
-	if err != nil {
+	pickedRecord, ok := v.femaleSampler.Pick(rng)
+	if !ok {
 		return "", false
 	}
 
@@ -75,15 +79,7 @@ func (v CreateCharacterGloveTableView) GetMaleRecords() []table.CreateCharacterG
 		return []table.CreateCharacterGloveRecord{}
 	}
 
-	records := v.createCharacterGlove.GetAll()
-	ret := make([]table.CreateCharacterGloveRecord, 0, len(records))
-	for _, record := range records {
-		if record.MaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-
-	return ret
+	return v.createCharacterGlove.GetMaleRecords()
 }
 
 // 여성 캐릭터 장갑 레코드를 모두 가져옵니다
@@ -92,13 +88,5 @@ func (v CreateCharacterGloveTableView) GetFemaleRecords() []table.CreateCharacte
 		return []table.CreateCharacterGloveRecord{}
 	}
 
-	records := v.createCharacterGlove.GetAll()
-	ret := make([]table.CreateCharacterGloveRecord, 0, len(records))
-	for _, record := range records {
-		if record.FemaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-
-	return ret
+	return v.createCharacterGlove.GetFemaleRecords()
 }
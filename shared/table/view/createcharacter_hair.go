@@ -7,10 +7,24 @@ import (
 	"math/rand/v2"
 )
 
+func newCreateCharacterHairTableView(createCharacter *table.CreateCharacterTable, createCharacterHair *table.CreateCharacterHairTable) CreateCharacterHairTableView {
+	v := CreateCharacterHairTableView{
+		CreateCharacter:     createCharacter,
+		CreateCharacterHair: createCharacterHair,
+	}
+	v.maleSampler = newRecordSampler(v.GetMaleRecords(), func(record table.CreateCharacterHairRecord) float64 { return record.MaleProb })
+	v.femaleSampler = newRecordSampler(v.GetFemaleRecords(), func(record table.CreateCharacterHairRecord) float64 { return record.FemaleProb })
+	return v
+}
+
 // 캐릭터 생성시 머리를 획득하기 위한 뷰입니다
 type CreateCharacterHairTableView struct {
 	CreateCharacter     *table.CreateCharacterTable
 	CreateCharacterHair *table.CreateCharacterHairTable
+
+	// 테이블 로드 시 만들어 두는 성별 샘플러
+	maleSampler   *util.AliasSampler[table.CreateCharacterHairRecord]
+	femaleSampler *util.AliasSampler[table.CreateCharacterHairRecord]
 }
 
 // 캐릭터 머리를 획득 할 수 있는지 확률을 통해 판단합니다
@@ -19,7 +33,7 @@ func (v CreateCharacterHairTableView) isHolding(rng *rand.Rand) bool {
 		return false
 	}
 
-	ccRecord, ok := v.CreateCharacter.Get(string(types.CharacterEquipType_Hair))
+	ccRecord, ok := v.CreateCharacter.Get(types.CharacterEquipType_Hair)
 	if !ok {
 		return false
 	}
@@ -37,13 +51,8 @@ func (v CreateCharacterHairTableView) GetMale(rng *rand.Rand) (string, bool) {
 		return "", false
 	}
 
-	weightedPicker := util.NewWeightedPicker[table.CreateCharacterHairRecord, float64](rng)
-	for _, record := range v.GetMaleRecords() {
-		weightedPicker.Add(record, record.MaleProb)
-	}
-
-	pickedRecord, err := weightedPicker.Pick()
-	if err != nil {
+	pickedRecord, ok := v.maleSampler.Pick(rng)
+	if !ok {
 		return "", false
 	}
 
@@ -56,13 +65,8 @@ func (v CreateCharacterHairTableView) GetFemale(rng *rand.Rand) (string, bool) {
 		return "", false
 	}
 
-	weightedPicker := util.NewWeightedPicker[table.CreateCharacterHairRecord, float64](rng)
-	for _, record := range v.GetFemaleRecords() {
-		weightedPicker.Add(record, record.FemaleProb)
-	}
-
-	pickedRecord, err := weightedPicker.Pick()
-	if err != nil {
+	pickedRecord, ok := v.femaleSampler.Pick(rng)
+	if !ok {
 		return "", false
 	}
 
@@ -75,15 +79,7 @@ func (v CreateCharacterHairTableView) GetMaleRecords() []table.CreateCharacterHa
 		return []table.CreateCharacterHairRecord{}
 	}
 
-	records := v.CreateCharacterHair.GetAll()
-	ret := make([]table.CreateCharacterHairRecord, 0, len(records))
-	for _, record := range records {
-		if record.MaleProb > 0.0 {
-			ret = append(ret, record)
-		}
-	}
-
-	return ret
+	return v.CreateCharacterHair.GetMaleRecords()
 }
 
 // 여성 캐릭터 머리 레코드를 모두 가져옵니다
@@ -92,13 +88,25 @@ func (v CreateCharacterHairTableView) GetFemaleRecords() []table.CreateCharacter
 		return []table.CreateCharacterHairRecord{}
 	}
 
-	records := v.CreateCharacterHair.GetAll()
-	ret := make([]table.CreateCharacterHairRecord, 0, len(records))
-	for _, record := range records {
-		if record.FemaleProb > 0.0 {
-			ret = append(ret, record)
-		}
+	return v.CreateCharacterHair.GetFemaleRecords()
+}
+
+// 남성 캐릭터가 생성 시 선택할 수 있는 머리인지 판단합니다
+func (v CreateCharacterHairTableView) IsMaleSelectable(index string) bool {
+	if v.CreateCharacterHair == nil {
+		return false
+	}
+
+	record, ok := v.CreateCharacterHair.Get(index)
+	return ok && record.MaleProb > 0
+}
+
+// 여성 캐릭터가 생성 시 선택할 수 있는 머리인지 판단합니다
+func (v CreateCharacterHairTableView) IsFemaleSelectable(index string) bool {
+	if v.CreateCharacterHair == nil {
+		return false
 	}
 
-	return ret
+	record, ok := v.CreateCharacterHair.Get(index)
+	return ok && record.FemaleProb > 0
 }
@@ -7,10 +7,24 @@ import (
 	"math/rand/v2"
 )
 
+func newCreateCharacterSkinTableView(createCharacter *table.CreateCharacterTable, createCharacterSkin *table.CreateCharacterSkinTable) CreateCharacterSkinTableView {
+	v := CreateCharacterSkinTableView{
+		createCharacter:     createCharacter,
+		createCharacterSkin: createCharacterSkin,
+	}
+	v.maleSampler = newRecordSampler(v.GetMaleRecords(), func(record table.CreateCharacterSkinRecord) float64 { return record.MaleProb })
+	v.femaleSampler = newRecordSampler(v.GetFemaleRecords(), func(record table.CreateCharacterSkinRecord) float64 { return record.FemaleProb })
+	return v
+}
+
 // 캐릭터 생성시 피부 색상을 획득하기 위한 뷰입니다
 type CreateCharacterSkinTableView struct {
 	createCharacter     *table.CreateCharacterTable
 	createCharacterSkin *table.CreateCharacterSkinTable
+
+	// 테이블 로드 시 만들어 두는 성별 샘플러
+	maleSampler   *util.AliasSampler[table.CreateCharacterSkinRecord]
+	femaleSampler *util.AliasSampler[table.CreateCharacterSkinRecord]
 }
 
 // 캐릭터 피부 색상을 획득 할 수 있는지 확률을 통해 판단합니다
@@ -19,7 +33,7 @@ func (v CreateCharacterSkinTableView) isHolding(rng *rand.Rand) bool {
 		return false
 	}
 
-	ccRecord, ok := v.createCharacter.Get(string(types.CharacterEquipType_Skin))
+	ccRecord, ok := v.createCharacter.Get(types.CharacterEquipType_Skin)
 	if !ok {
 		return false
 	}
@@ -37,13 +51,8 @@ func (v CreateCharacterSkinTableView) GetMale(rng *rand.Rand) (string, bool) {
 		return "", false
 	}
 
-	weightedPicker := util.NewWeightedPicker[table.CreateCharacterSkinRecord, float64](rng)
-	for _, record := range v.GetMaleRecords() {
-		weightedPicker.Add(record, record.MaleProb)
-	}
-
-	pickedRecord, err := weightedPicker.Pick()
-	if err != nil {
+	pickedRecord, ok := v.maleSampler.Pick(rng)
+	if !ok {
 		return "", false
 	}
 
@@ -56,13 +65,8 @@ func (v CreateCharacterSkinTableView) GetFemale(rng *rand.Rand) (string, bool) {
 		return "", false
 	}
 
-	weightedPicker := util.NewWeightedPicker[table.CreateCharacterSkinRecord, float64](rng)
-	for _, record := range v.GetFemaleRecords() {
-		weightedPicker.Add(record, record.FemaleProb)
-	}
-
-	pickedRecord, err := weightedPicker.Pick()
-	if err != nil {
+	pickedRecord, ok := v.femaleSampler.Pick(rng)
+	if !ok {
 		return "", false
 	}
 
@@ -75,15 +79,7 @@ func (v CreateCharacterSkinTableView) GetMaleRecords() []table.CreateCharacterSk
 		return []table.CreateCharacterSkinRecord{}
 	}
 
-	records := v.createCharacterSkin.GetAll()
-	ret := make([]table.CreateCharacterSkinRecord, 0, len(records))
-	for _, record := range records {
-		if record.MaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-
-	return ret
+	return v.createCharacterSkin.GetMaleRecords()
 }
 
 // 여성 캐릭터 피부 색상 레코드를 모두 가져옵니다
@@ -92,13 +88,25 @@ func (v CreateCharacterSkinTableView) GetFemaleRecords() []table.CreateCharacter
 		return []table.CreateCharacterSkinRecord{}
 	}
 
-	records := v.createCharacterSkin.GetAll()
-	ret := make([]table.CreateCharacterSkinRecord, 0, len(records))
-	for _, record := range records {
-		if record.FemaleProb > 0 {
-			ret = append(ret, record)
-		}
+	return v.createCharacterSkin.GetFemaleRecords()
+}
+
+// 남성 캐릭터가 생성 시 선택할 수 있는 피부 색상인지 판단합니다
+func (v CreateCharacterSkinTableView) IsMaleSelectable(index string) bool {
+	if v.createCharacterSkin == nil {
+		return false
+	}
+
+	record, ok := v.createCharacterSkin.Get(index)
+	return ok && record.MaleProb > 0
+}
+
+// 여성 캐릭터가 생성 시 선택할 수 있는 피부 색상인지 판단합니다
+func (v CreateCharacterSkinTableView) IsFemaleSelectable(index string) bool {
+	if v.createCharacterSkin == nil {
+		return false
 	}
 
-	return ret
+	record, ok := v.createCharacterSkin.Get(index)
+	return ok && record.FemaleProb > 0
 }
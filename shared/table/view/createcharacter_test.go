@@ -25,26 +25,10 @@ func TestCreateCharacterView(t *testing.T) {
 		t.Fatalf("failed to load table repository: %v", err)
 	}
 
-	view := view.NewCreateCharacterView(
-		tableRepo.CreateCharacter,
-		tableRepo.CreateCharacterHair,
-		tableRepo.CreateCharacterFace,
-		tableRepo.CreateCharacterCap,
-		tableRepo.CreateCharacterCape,
-		tableRepo.CreateCharacterCoat,
-		tableRepo.CreateCharacterGlove,
-		tableRepo.CreateCharacterLongCoat,
-		tableRepo.CreateCharacterPants,
-		tableRepo.CreateCharacterShoes,
-		tableRepo.CreateCharacterFaceAcc,
-		tableRepo.CreateCharacterEysAcc,
-		tableRepo.CreateCharacterEarAcc,
-		tableRepo.CreateCharacter1HWeapon,
-		tableRepo.CreateCharacter2HWeapon,
-		tableRepo.CreateCharacterSubWeapon,
-		tableRepo.CreateCharacterEar,
-		tableRepo.CreateCharacterSkin,
-	)
+	view, err := view.NewCreateCharacterView(tableRepo)
+	if err != nil {
+		t.Fatalf("failed to create character view: %v", err)
+	}
 
 	seed := time.Now().UnixNano()
 	rng := rand.New(rand.NewPCG(uint64(seed), uint64(seed)))
@@ -74,3 +58,69 @@ func TestCreateCharacterView(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkCreateCharacterView(b *testing.B) {
+	wd, err := os.Getwd()
+	if err != nil {
+		b.Fatalf("failed to get working directory: %v", err)
+	}
+	dataPath := filepath.Join(wd, "../../../data")
+
+	tableRepo := &table.Repository{}
+	if err := tableRepo.Load(dataPath); err != nil {
+		b.Fatalf("failed to load table repository: %v", err)
+	}
+
+	view, err := view.NewCreateCharacterView(tableRepo)
+	if err != nil {
+		b.Fatalf("failed to create character view: %v", err)
+	}
+
+	b.RunParallel(func(pb *testing.PB) {
+		rng := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+		for pb.Next() {
+			_ = view.GetMale(rng)
+			_ = view.GetFemale(rng)
+		}
+	})
+}
+
+func TestCreateCharacterViewSelectableAppearance(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to get working directory: %v", err)
+	}
+	dataPath := filepath.Join(wd, "../../../data")
+
+	tableRepo := &table.Repository{}
+	if err := tableRepo.Load(dataPath); err != nil {
+		t.Fatalf("failed to load table repository: %v", err)
+	}
+
+	view, err := view.NewCreateCharacterView(tableRepo)
+	if err != nil {
+		t.Fatalf("failed to create character view: %v", err)
+	}
+
+	testCases := []struct {
+		gender    int
+		equipType types.CharacterEquipType
+		index     string
+		expected  bool
+	}{
+		{gender: types.GenderType_Male, equipType: types.CharacterEquipType_Hair, index: "hair-1033", expected: true},
+		{gender: types.GenderType_Female, equipType: types.CharacterEquipType_Hair, index: "hair-1033", expected: false},
+		{gender: types.GenderType_Female, equipType: types.CharacterEquipType_Hair, index: "hair-2222", expected: true},
+		{gender: types.GenderType_Male, equipType: types.CharacterEquipType_Ear, index: "ear-6", expected: true},
+		{gender: types.GenderType_Male, equipType: types.CharacterEquipType_Hair, index: "hair-0", expected: false},
+		{gender: types.GenderType_Male, equipType: types.CharacterEquipType_Face, index: "hair-1033", expected: false},
+		{gender: types.GenderType_Male, equipType: types.CharacterEquipType_Cap, index: "cap-1", expected: false},
+		{gender: 0, equipType: types.CharacterEquipType_Hair, index: "hair-1033", expected: false},
+	}
+
+	for _, tc := range testCases {
+		if got := view.IsSelectableAppearance(tc.gender, tc.equipType, tc.index); got != tc.expected {
+			t.Errorf("IsSelectableAppearance(%d, %s, %s) = %v, expected %v", tc.gender, tc.equipType, tc.index, got, tc.expected)
+		}
+	}
+}
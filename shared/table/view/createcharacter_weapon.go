@@ -7,12 +7,36 @@ import (
 	"math/rand/v2"
 )
 
+func newCreateCharacterWeaponTableView(createCharacter *table.CreateCharacterTable, createCharacter1HWeapon *table.CreateCharacter1HWeaponTable, createCharacter2HWeapon *table.CreateCharacter2HWeaponTable, createCharacterSubWeapon *table.CreateCharacterSubWeaponTable) CreateCharacterWeaponTableView {
+	v := CreateCharacterWeaponTableView{
+		createCharacter:          createCharacter,
+		createCharacter1HWeapon:  createCharacter1HWeapon,
+		createCharacter2HWeapon:  createCharacter2HWeapon,
+		createCharacterSubWeapon: createCharacterSubWeapon,
+	}
+	v.male1HWeaponSampler = newRecordSampler(v.GetMale1HWeapon(nil), func(record table.CreateCharacter1HWeaponRecord) float64 { return record.MaleProb })
+	v.female1HWeaponSampler = newRecordSampler(v.GetFemale1HWeapon(nil), func(record table.CreateCharacter1HWeaponRecord) float64 { return record.FemaleProb })
+	v.male2HWeaponSampler = newRecordSampler(v.GetMale2HWeapon(nil), func(record table.CreateCharacter2HWeaponRecord) float64 { return record.MaleProb })
+	v.female2HWeaponSampler = newRecordSampler(v.GetFemale2HWeapon(nil), func(record table.CreateCharacter2HWeaponRecord) float64 { return record.FemaleProb })
+	v.maleSubWeaponSampler = newRecordSampler(v.GetMaleSubWeapon(nil), func(record table.CreateCharacterSubWeaponRecord) float64 { return record.MaleProb })
+	v.femaleSubWeaponSampler = newRecordSampler(v.GetFemaleSubWeapon(nil), func(record table.CreateCharacterSubWeaponRecord) float64 { return record.FemaleProb })
+	return v
+}
+
 // 캐릭터 생성시 무기를 획득하기 위한 뷰입니다
 type CreateCharacterWeaponTableView struct {
 	createCharacter          *table.CreateCharacterTable
 	createCharacter1HWeapon  *table.CreateCharacter1HWeaponTable
 	createCharacter2HWeapon  *table.CreateCharacter2HWeaponTable
 	createCharacterSubWeapon *table.CreateCharacterSubWeaponTable
+
+	// 테이블 로드 시 만들어 두는 성별 샘플러
+	male1HWeaponSampler    *util.AliasSampler[table.CreateCharacter1HWeaponRecord]
+	female1HWeaponSampler  *util.AliasSampler[table.CreateCharacter1HWeaponRecord]
+	male2HWeaponSampler    *util.AliasSampler[table.CreateCharacter2HWeaponRecord]
+	female2HWeaponSampler  *util.AliasSampler[table.CreateCharacter2HWeaponRecord]
+	maleSubWeaponSampler   *util.AliasSampler[table.CreateCharacterSubWeaponRecord]
+	femaleSubWeaponSampler *util.AliasSampler[table.CreateCharacterSubWeaponRecord]
 }
 
 // 캐릭터 무기를 획득 할 수 있는지 확률을 통해 판단합니다
@@ -26,7 +50,7 @@ func (v CreateCharacterWeaponTableView) isHolding(rng *rand.Rand) ([]types.Chara
 	var isHolding2HWeapon bool
 
 	// 2H 무기를 먼저 판단합니다. 2H 무기를 획득한 경우 1H 무기와 보조 무기를 획득할 수 없습니다
-	if twoHandRecord, ok := v.createCharacter.Get(string(types.CharacterEquipType_2HWeapon)); ok {
+	if twoHandRecord, ok := v.createCharacter.Get(types.CharacterEquipType_2HWeapon); ok {
 		singleProb.Set(types.CharacterEquipType_2HWeapon, twoHandRecord.HoldingProb)
 		if _, ok := singleProb.Pick(); ok {
 			ret = append(ret, types.CharacterEquipType_2HWeapon)
@@ -36,14 +60,14 @@ func (v CreateCharacterWeaponTableView) isHolding(rng *rand.Rand) ([]types.Chara
 
 	// 2H 무기를 획득한 경우 1H 무기와 보조 무기를 획득할 수 없습니다
 	if !isHolding2HWeapon {
-		if oneHandRecord, ok := v.createCharacter.Get(string(types.CharacterEquipType_1HWeapon)); ok {
+		if oneHandRecord, ok := v.createCharacter.Get(types.CharacterEquipType_1HWeapon); ok {
 			singleProb.Set(types.CharacterEquipType_1HWeapon, oneHandRecord.HoldingProb)
 			if _, ok := singleProb.Pick(); ok {
 				ret = append(ret, types.CharacterEquipType_1HWeapon)
 			}
 		}
 
-		if subWeaponRecord, ok := v.createCharacter.Get(string(types.CharacterEquipType_SubWeapon)); ok {
+		if subWeaponRecord, ok := v.createCharacter.Get(types.CharacterEquipType_SubWeapon); ok {
 			singleProb.Set(types.CharacterEquipType_SubWeapon, subWeaponRecord.HoldingProb)
 			if _, ok := singleProb.Pick(); ok {
 				ret = append(ret, types.CharacterEquipType_SubWeapon)
@@ -66,36 +90,21 @@ func (v CreateCharacterWeaponTableView) GetMale(rng *rand.Rand) (map[types.Chara
 	for _, t := range holdingTypes {
 		switch t {
 		case types.CharacterEquipType_1HWeapon:
-			weightedPicker := util.NewWeightedPicker[table.CreateCharacter1HWeaponRecord, float64](rng)
-			for _, record := range v.GetMale1HWeapon(rng) {
-				weightedPicker.Add(record, record.MaleProb)
-			}
-
-			pickedRecord, err := weightedPicker.Pick()
-			if err == nil {
+			pickedRecord, ok := v.male1HWeaponSampler.Pick(rng)
+			if ok {
 				ret[types.CharacterEquipType_1HWeapon] = pickedRecord.Index
 			}
 
 		case types.CharacterEquipType_2HWeapon:
-			weightedPicker := util.NewWeightedPicker[table.CreateCharacter2HWeaponRecord, float64](rng)
-			for _, record := range v.GetMale2HWeapon(rng) {
-				weightedPicker.Add(record, record.MaleProb)
-			}
-
-			pickedRecord, err := weightedPicker.Pick()
-			if err == nil {
+			pickedRecord, ok := v.male2HWeaponSampler.Pick(rng)
+			if ok {
 				ret[types.CharacterEquipType_2HWeapon] = pickedRecord.Index
 			}
 
 		case types.CharacterEquipType_SubWeapon:
-			weightedPicker := util.NewWeightedPicker[table.CreateCharacterSubWeaponRecord, float64](rng)
-			for _, record := range v.GetMaleSubWeapon(rng) {
-				weightedPicker.Add(record, record.MaleProb)
-			}
-
-			pickedRecord, err := weightedPicker.Pick()
-			if err == nil {
-				ret[types.CharacterEquipType(pickedRecord.Category)] = pickedRecord.Index
+			pickedRecord, ok := v.maleSubWeaponSampler.Pick(rng)
+			if ok {
+				ret[pickedRecord.Category] = pickedRecord.Index
 			}
 		}
 	}
@@ -115,36 +124,21 @@ func (v CreateCharacterWeaponTableView) GetFemale(rng *rand.Rand) (map[types.Cha
 	for _, t := range holdingTypes {
 		switch t {
 		case types.CharacterEquipType_1HWeapon:
-			weightedPicker := util.NewWeightedPicker[table.CreateCharacter1HWeaponRecord, float64](rng)
-			for _, record := range v.GetFemale1HWeapon(rng) {
-				weightedPicker.Add(record, record.FemaleProb)
-			}
-
-			pickedRecord, err := weightedPicker.Pick()
-			if err == nil {
+			pickedRecord, ok := v.female1HWeaponSampler.Pick(rng)
+			if ok {
 				ret[types.CharacterEquipType_1HWeapon] = pickedRecord.Index
 			}
 
 		case types.CharacterEquipType_2HWeapon:
-			weightedPicker := util.NewWeightedPicker[table.CreateCharacter2HWeaponRecord, float64](rng)
-			for _, record := range v.GetFemale2HWeapon(rng) {
-				weightedPicker.Add(record, record.FemaleProb)
-			}
-
-			pickedRecord, err := weightedPicker.Pick()
-			if err == nil {
+			pickedRecord, ok := v.female2HWeaponSampler.Pick(rng)
+			if ok {
 				ret[types.CharacterEquipType_2HWeapon] = pickedRecord.Index
 			}
 
 		case types.CharacterEquipType_SubWeapon:
-			weightedPicker := util.NewWeightedPicker[table.CreateCharacterSubWeaponRecord, float64](rng)
-			for _, record := range v.GetFemaleSubWeapon(rng) {
-				weightedPicker.Add(record, record.FemaleProb)
-			}
-
-			pickedRecord, err := weightedPicker.Pick()
-			if err == nil {
-				ret[types.CharacterEquipType(pickedRecord.Category)] = pickedRecord.Index
+			pickedRecord, ok := v.femaleSubWeaponSampler.Pick(rng)
+			if ok {
+				ret[pickedRecord.Category] = pickedRecord.Index
 			}
 		}
 	}
@@ -158,15 +152,7 @@ func (v CreateCharacterWeaponTableView) GetMale1HWeapon(rng *rand.Rand) []table.
 		return nil
 	}
 
-	records := v.createCharacter1HWeapon.GetAll()
-	ret := make([]table.CreateCharacter1HWeaponRecord, 0, len(records))
-	for _, record := range records {
-		if record.MaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-
-	return ret
+	return v.createCharacter1HWeapon.GetMaleRecords()
 }
 
 // 여성 캐릭터 한손 무기를 획득 할 수 있는지 확률을 통해 판단합니다
@@ -175,16 +161,7 @@ func (v CreateCharacterWeaponTableView) GetFemale1HWeapon(rng *rand.Rand) []tabl
 		return nil
 	}
 
-	records := v.createCharacter1HWeapon.GetAll()
-	ret := make([]table.CreateCharacter1HWeaponRecord, 0, len(records))
-
-	for _, record := range records {
-		if record.FemaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-
-	return ret
+	return v.createCharacter1HWeapon.GetFemaleRecords()
 }
 
 // 남성 캐릭터 양손 무기를 획득 할 수 있는지 확률을 통해 판단합니다
@@ -193,16 +170,7 @@ func (v CreateCharacterWeaponTableView) GetMale2HWeapon(rng *rand.Rand) []table.
 		return nil
 	}
 
-	records := v.createCharacter2HWeapon.GetAll()
-	ret := make([]table.CreateCharacter2HWeaponRecord, 0, len(records))
-
-	for _, record := range records {
-		if record.MaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-
-	return ret
+	return v.createCharacter2HWeapon.GetMaleRecords()
 }
 
 // 여성 캐릭터 양손 무기를 획득 할 수 있는지 확률을 통해 판단합니다
@@ -211,16 +179,7 @@ func (v CreateCharacterWeaponTableView) GetFemale2HWeapon(rng *rand.Rand) []tabl
 		return nil
 	}
 
-	records := v.createCharacter2HWeapon.GetAll()
-	ret := make([]table.CreateCharacter2HWeaponRecord, 0, len(records))
-
-	for _, record := range records {
-		if record.FemaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-
-	return ret
+	return v.createCharacter2HWeapon.GetFemaleRecords()
 }
 
 // 남성 캐릭터 보조 무기를 획득 할 수 있는지 확률을 통해 판단합니다
@@ -229,15 +188,7 @@ func (v CreateCharacterWeaponTableView) GetMaleSubWeapon(rng *rand.Rand) []table
 		return nil
 	}
 
-	records := v.createCharacterSubWeapon.GetAll()
-	ret := make([]table.CreateCharacterSubWeaponRecord, 0, len(records))
-	for _, record := range records {
-		if record.MaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-
-	return ret
+	return v.createCharacterSubWeapon.GetMaleRecords()
 }
 
 // 여성 캐릭터 보조 무기를 획득 할 수 있는지 확률을 통해 판단합니다
@@ -246,14 +197,5 @@ func (v CreateCharacterWeaponTableView) GetFemaleSubWeapon(rng *rand.Rand) []tab
 		return nil
 	}
 
-	records := v.createCharacterSubWeapon.GetAll()
-	ret := make([]table.CreateCharacterSubWeaponRecord, 0, len(records))
-
-	for _, record := range records {
-		if record.FemaleProb > 0 {
-			ret = append(ret, record)
-		}
-	}
-
-	return ret
+	return v.createCharacterSubWeapon.GetFemaleRecords()
 }
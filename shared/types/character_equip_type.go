@@ -1,5 +1,7 @@
 package types
 
+import "strings"
+
 // CharacterEquipType은 캐릭터의 장비 종류를 나타내는 타입입니다
 type CharacterEquipType string
 
@@ -66,3 +68,71 @@ const (
 	// 피부
 	CharacterEquipType_Skin = CharacterEquipType("skin")
 )
+
+// 아이템 인덱스(예: coat-1)의 접두어로 캐릭터 장비 타입을 구합니다
+func GetCharacterEquipTypeByIndex(index string) CharacterEquipType {
+	i := strings.LastIndex(index, "-")
+	if i <= 0 {
+		return CharacterEquipType_None
+	}
+
+	return ItemCategory(index[:i]).EquipType()
+}
+
+// 장비 타입이 차지하는 장비 슬롯 타입을 구합니다
+// 한벌옷은 상의 슬롯, 두손 무기는 한손 무기 슬롯, 방패는 보조 무기 슬롯을 사용합니다 (총 CharacterEquipSlotCount개)
+func (t CharacterEquipType) SlotType() CharacterEquipType {
+	switch t {
+	case CharacterEquipType_LongCoat:
+		return CharacterEquipType_Coat
+	case CharacterEquipType_2HWeapon:
+		return CharacterEquipType_1HWeapon
+	case CharacterEquipType_Shield:
+		return CharacterEquipType_SubWeapon
+	default:
+		return t
+	}
+}
+
+// 장착 시 함께 해제되어야 하는 장비 타입 목록을 구합니다
+// 한벌옷은 하의와, 두손 무기는 보조 무기/방패와 함께 장착할 수 없습니다
+func (t CharacterEquipType) ExclusiveTypes() []CharacterEquipType {
+	switch t {
+	case CharacterEquipType_LongCoat:
+		return []CharacterEquipType{CharacterEquipType_Coat, CharacterEquipType_Pants}
+	case CharacterEquipType_Coat, CharacterEquipType_Pants:
+		return []CharacterEquipType{CharacterEquipType_LongCoat}
+	case CharacterEquipType_2HWeapon:
+		return []CharacterEquipType{CharacterEquipType_1HWeapon, CharacterEquipType_SubWeapon, CharacterEquipType_Shield}
+	case CharacterEquipType_1HWeapon, CharacterEquipType_SubWeapon, CharacterEquipType_Shield:
+		return []CharacterEquipType{CharacterEquipType_2HWeapon}
+	default:
+		return nil
+	}
+}
+
+// 캐릭터에서 해제할 수 없는 필수 장비 타입인지 판단합니다
+func (t CharacterEquipType) IsRequired() bool {
+	switch t {
+	case CharacterEquipType_Hair, CharacterEquipType_Face, CharacterEquipType_Ear, CharacterEquipType_Skin:
+		return true
+	default:
+		return false
+	}
+}
+
+// 문자열을 캐릭터 장비 타입으로 변환합니다
+func ParseCharacterEquipType(s string) (CharacterEquipType, bool) {
+	t := CharacterEquipType(s)
+	return t, t.IsValid()
+}
+
+// 유효한 캐릭터 장비 타입인지 판단합니다
+func (t CharacterEquipType) IsValid() bool {
+	for _, equipType := range itemCategoryEquipTypes {
+		if equipType == t {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,22 @@
+package types
+
+// InventoryType은 아이템이 보관되는 인벤토리 종류를 나타내는 타입입니다
+type InventoryType string
+
+const (
+	// 인벤토리에 보관할 수 없는 아이템 (헤어, 얼굴, 피부 등)
+	InventoryType_None = InventoryType("none")
+
+	// 기타 인벤토리
+	InventoryType_Misc = InventoryType("misc")
+)
+
+// 문자열을 인벤토리 종류로 변환합니다
+func ParseInventoryType(s string) (InventoryType, bool) {
+	switch t := InventoryType(s); t {
+	case InventoryType_None, InventoryType_Misc:
+		return t, true
+	default:
+		return t, false
+	}
+}
@@ -0,0 +1,97 @@
+package types
+
+// ItemCategory는 아이템 테이블의 아이템 분류를 나타내는 타입입니다 (아이템 인덱스 접두어와 같습니다)
+type ItemCategory string
+
+const (
+	// 피부
+	ItemCategory_Body = ItemCategory("body")
+
+	// 헤어
+	ItemCategory_Hair = ItemCategory("hair")
+
+	// 얼굴
+	ItemCategory_Face = ItemCategory("face")
+
+	// 모자
+	ItemCategory_Cap = ItemCategory("cap")
+
+	// 망토
+	ItemCategory_Cape = ItemCategory("cape")
+
+	// 상의
+	ItemCategory_Coat = ItemCategory("coat")
+
+	// 장갑
+	ItemCategory_Glove = ItemCategory("glove")
+
+	// 한벌 옷
+	ItemCategory_LongCoat = ItemCategory("longcoat")
+
+	// 하의
+	ItemCategory_Pants = ItemCategory("pants")
+
+	// 신발
+	ItemCategory_Shoes = ItemCategory("shoes")
+
+	// 얼굴 장식
+	ItemCategory_FaceAccessory = ItemCategory("faceaccessory")
+
+	// 눈 장식
+	ItemCategory_EyeAccessory = ItemCategory("eyeaccessory")
+
+	// 귀 장식
+	ItemCategory_EarAccessory = ItemCategory("earaccessory")
+
+	// 한손 무기
+	ItemCategory_1HWeapon = ItemCategory("onehandedweapon")
+
+	// 두손 무기
+	ItemCategory_2HWeapon = ItemCategory("twohandedweapon")
+
+	// 보조 무기
+	ItemCategory_SubWeapon = ItemCategory("subweapon")
+
+	// 방패
+	ItemCategory_Shield = ItemCategory("shield")
+
+	// 귀
+	ItemCategory_Ear = ItemCategory("ear")
+)
+
+// 아이템 분류별 캐릭터 장비 타입
+var itemCategoryEquipTypes = map[ItemCategory]CharacterEquipType{
+	ItemCategory_Body:          CharacterEquipType_Skin,
+	ItemCategory_Hair:          CharacterEquipType_Hair,
+	ItemCategory_Face:          CharacterEquipType_Face,
+	ItemCategory_Cap:           CharacterEquipType_Cap,
+	ItemCategory_Cape:          CharacterEquipType_Cape,
+	ItemCategory_Coat:          CharacterEquipType_Coat,
+	ItemCategory_Glove:         CharacterEquipType_Glove,
+	ItemCategory_LongCoat:      CharacterEquipType_LongCoat,
+	ItemCategory_Pants:         CharacterEquipType_Pants,
+	ItemCategory_Shoes:         CharacterEquipType_Shoes,
+	ItemCategory_FaceAccessory: CharacterEquipType_FaceAccessory,
+	ItemCategory_EyeAccessory:  CharacterEquipType_EyeAccessory,
+	ItemCategory_EarAccessory:  CharacterEquipType_EarAccessory,
+	ItemCategory_1HWeapon:      CharacterEquipType_1HWeapon,
+	ItemCategory_2HWeapon:      CharacterEquipType_2HWeapon,
+	ItemCategory_SubWeapon:     CharacterEquipType_SubWeapon,
+	ItemCategory_Shield:        CharacterEquipType_Shield,
+	ItemCategory_Ear:           CharacterEquipType_Ear,
+}
+
+// 문자열을 아이템 분류로 변환합니다
+func ParseItemCategory(s string) (ItemCategory, bool) {
+	category := ItemCategory(s)
+	_, ok := itemCategoryEquipTypes[category]
+	return category, ok
+}
+
+// 아이템 분류에 해당하는 캐릭터 장비 타입을 구합니다
+func (c ItemCategory) EquipType() CharacterEquipType {
+	if equipType, ok := itemCategoryEquipTypes[c]; ok {
+		return equipType
+	}
+	return CharacterEquipType_None
+}
@@ -0,0 +1,22 @@
+package types
+
+// NameFilterType은 이름 필터 단어를 적용하는 방식을 나타내는 타입입니다
+type NameFilterType string
+
+const (
+	// 이름에 단어가 포함되어 있으면 사용할 수 없습니다
+	NameFilterType_Banned = NameFilterType("banned")
+
+	// 이름이 단어와 같으면 사용할 수 없습니다 (운영자 이름 등)
+	NameFilterType_Reserved = NameFilterType("reserved")
+)
+
+// 문자열을 이름 필터 방식으로 변환합니다
+func ParseNameFilterType(s string) (NameFilterType, bool) {
+	switch t := NameFilterType(s); t {
+	case NameFilterType_Banned, NameFilterType_Reserved:
+		return t, true
+	default:
+		return t, false
+	}
+}
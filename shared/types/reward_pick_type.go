@@ -0,0 +1,25 @@
+package types
+
+// RewardPickType은 보상 그룹에서 보상을 뽑는 방식을 나타내는 타입입니다
+type RewardPickType string
+
+const (
+	// 가중치에 따라 하나를 뽑습니다
+	RewardPickType_Weighted = RewardPickType("weighted")
+
+	// 각 보상마다 독립적으로 확률(가중치/최대 가중치)을 굴려 당첨된 보상을 모두 지급합니다
+	RewardPickType_Independent = RewardPickType("independent")
+
+	// 가중치에 따라 하나를 뽑되, 유저별로 모든 보상이 한 번씩 나올 때까지 중복되지 않습니다
+	RewardPickType_Deck = RewardPickType("deck")
+)
+
+// 문자열을 보상 뽑기 방식으로 변환합니다
+func ParseRewardPickType(s string) (RewardPickType, bool) {
+	switch t := RewardPickType(s); t {
+	case RewardPickType_Weighted, RewardPickType_Independent, RewardPickType_Deck:
+		return t, true
+	default:
+		return t, false
+	}
+}
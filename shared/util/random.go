@@ -189,3 +189,97 @@ func (s *SingleProbabilisticData[T, W]) Pick() (T, bool) {
 
 	return empty, false
 }
+
+// 5. AliasSampler: Data와 weight로 한 번 만들어 두면 O(1)로 하나를 선택하는 랜덤 (Vose alias method)
+// 생성 후에는 변경되지 않으므로 여러 고루틴에서 공유해도 안전합니다. 테이블 로드 시 한 번 만들어 재사용하는 용도입니다
+type AliasSampler[T any] struct {
+	items []T
+	prob  []float64
+	alias []int
+}
+
+// weight가 0 이하인 Data는 제외합니다. 선택할 수 있는 Data가 없으면 Pick은 항상 실패합니다
+func NewAliasSampler[T any, W Number](items []Item[T, W]) *AliasSampler[T] {
+	a := &AliasSampler[T]{
+		items: make([]T, 0, len(items)),
+	}
+
+	var totalWeight float64
+	weights := make([]float64, 0, len(items))
+	for _, item := range items {
+		if item.Weight <= 0 {
+			continue
+		}
+		a.items = append(a.items, item.Data)
+		weights = append(weights, float64(item.Weight))
+		totalWeight += float64(item.Weight)
+	}
+
+	n := len(a.items)
+	if n == 0 {
+		return a
+	}
+
+	a.prob = make([]float64, n)
+	a.alias = make([]int, n)
+
+	// 평균이 1이 되도록 가중치를 조정한 뒤 1보다 작은 칸과 큰 칸을 짝지어 채웁니다
+	small := make([]int, 0, n)
+	large := make([]int, 0, n)
+	for i, weight := range weights {
+		weights[i] = weight * float64(n) / totalWeight
+		if weights[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+
+	for len(small) > 0 && len(large) > 0 {
+		l := small[len(small)-1]
+		small = small[:len(small)-1]
+		g := large[len(large)-1]
+		large = large[:len(large)-1]
+
+		a.prob[l] = weights[l]
+		a.alias[l] = g
+
+		weights[g] = weights[g] + weights[l] - 1
+		if weights[g] < 1 {
+			small = append(small, g)
+		} else {
+			large = append(large, g)
+		}
+	}
+
+	// 남은 칸은 부동소수점 오차를 제외하면 모두 1입니다
+	for _, i := range large {
+		a.prob[i] = 1
+	}
+	for _, i := range small {
+		a.prob[i] = 1
+	}
+
+	return a
+}
+
+func (a *AliasSampler[T]) Pick(rng *rand.Rand) (T, bool) {
+	var empty T
+	if a == nil || len(a.items) == 0 {
+		return empty, false
+	}
+
+	i := rng.IntN(len(a.items))
+	if rng.Float64() < a.prob[i] {
+		return a.items[i], true
+	}
+	return a.items[a.alias[i]], true
+}
+
+// 선택할 수 있는 Data의 개수를 반환합니다
+func (a *AliasSampler[T]) Len() int {
+	if a == nil {
+		return 0
+	}
+	return len(a.items)
+}
@@ -0,0 +1,107 @@
+package util_test
+
+import (
+	"MScannot206/shared/util"
+	"math"
+	"math/rand/v2"
+	"strconv"
+	"testing"
+)
+
+func newWeightedItems(n int) []util.Item[int, float64] {
+	items := make([]util.Item[int, float64], 0, n)
+	for i := range n {
+		items = append(items, util.Item[int, float64]{Data: i, Weight: float64(i%7 + 1)})
+	}
+	return items
+}
+
+func TestAliasSampler(t *testing.T) {
+	items := []util.Item[string, float64]{
+		{Data: "a", Weight: 1},
+		{Data: "b", Weight: 2},
+		{Data: "c", Weight: 0},
+		{Data: "d", Weight: 7},
+	}
+
+	sampler := util.NewAliasSampler(items)
+	if sampler.Len() != 3 {
+		t.Fatalf("expected 3 items, got %d", sampler.Len())
+	}
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	const draws = 200000
+	counts := make(map[string]int)
+	for range draws {
+		data, ok := sampler.Pick(rng)
+		if !ok {
+			t.Fatalf("pick failed")
+		}
+		counts[data]++
+	}
+
+	if counts["c"] != 0 {
+		t.Errorf("zero weight item was picked %d times", counts["c"])
+	}
+
+	for data, expected := range map[string]float64{"a": 0.1, "b": 0.2, "d": 0.7} {
+		observed := float64(counts[data]) / draws
+		if math.Abs(observed-expected) > 0.01 {
+			t.Errorf("%s: expected %.3f, observed %.3f", data, expected, observed)
+		}
+	}
+
+	var empty *util.AliasSampler[string]
+	if _, ok := empty.Pick(rng); ok {
+		t.Errorf("nil sampler should not pick")
+	}
+	if _, ok := util.NewAliasSampler([]util.Item[string, float64]{{Data: "a", Weight: 0}}).Pick(rng); ok {
+		t.Errorf("sampler without positive weight should not pick")
+	}
+}
+
+func BenchmarkWeightedPicker(b *testing.B) {
+	for _, n := range []int{8, 64, 512} {
+		items := newWeightedItems(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			rng := rand.New(rand.NewPCG(1, 2))
+			for b.Loop() {
+				// 뷰에서 추첨할 때마다 피커를 새로 만들던 방식과 같습니다
+				picker := util.NewWeightedPicker[int, float64](rng)
+				for _, item := range items {
+					picker.Add(item.Data, item.Weight)
+				}
+				_, _ = picker.Pick()
+			}
+		})
+	}
+}
+
+func BenchmarkAliasSampler(b *testing.B) {
+	for _, n := range []int{8, 64, 512} {
+		sampler := util.NewAliasSampler(newWeightedItems(n))
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			rng := rand.New(rand.NewPCG(1, 2))
+			for b.Loop() {
+				_, _ = sampler.Pick(rng)
+			}
+		})
+	}
+}
+
+func BenchmarkAliasSamplerParallel(b *testing.B) {
+	sampler := util.NewAliasSampler(newWeightedItems(512))
+	b.RunParallel(func(pb *testing.PB) {
+		rng := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+		for pb.Next() {
+			_, _ = sampler.Pick(rng)
+		}
+	})
+}
+
+func BenchmarkNewAliasSampler(b *testing.B) {
+	items := newWeightedItems(512)
+	for b.Loop() {
+		_ = util.NewAliasSampler(items)
+	}
+}
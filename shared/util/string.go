@@ -1,25 +1,100 @@
 package util
 
 import (
-	"MScannot206/shared/def"
-	"regexp"
-)
+	"strings"
+	"unicode"
 
-var (
-	specialCharPatternKorean   = regexp.MustCompile(`[^가-힣a-zA-Z0-9]`)
-	specialCharPatternEnglish  = regexp.MustCompile(`[^a-zA-Z0-9]`)
-	specialCharPatternJapanese = regexp.MustCompile(`[^\p{Hiragana}\p{Katakana}\p{Han}a-zA-Z0-9]`)
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
 )
 
-func HasSpecialChar(text string, locale def.Locale) bool {
-	switch locale {
-	case def.LocaleKorean:
-		return specialCharPatternKorean.MatchString(text)
-	case def.LocaleEnglish:
-		return specialCharPatternEnglish.MatchString(text)
-	case def.LocaleJapanese:
-		return specialCharPatternJapanese.MatchString(text)
+// 모양이 비슷하여 필터를 우회하는 데 쓰이는 문자를 라틴 소문자로 바꾸는 표
+// NFKC 정규화와 대소문자 통합 이후에 적용하므로 전각 문자와 대문자는 포함하지 않습니다
+var confusableRunes = map[rune]rune{
+	// 숫자, 기호
+	'0': 'o',
+	'1': 'i',
+	'3': 'e',
+	'4': 'a',
+	'5': 's',
+	'7': 't',
+	'8': 'b',
+	'@': 'a',
+	'$': 's',
+	'!': 'i',
+	'|': 'l',
+
+	// 키릴 문자
+	'а': 'a',
+	'в': 'b',
+	'е': 'e',
+	'к': 'k',
+	'м': 'm',
+	'н': 'h',
+	'о': 'o',
+	'р': 'p',
+	'с': 'c',
+	'т': 't',
+	'у': 'y',
+	'х': 'x',
+	'і': 'i',
+	'ѕ': 's',
+
+	// 그리스 문자
+	'α': 'a',
+	'β': 'b',
+	'ε': 'e',
+	'ι': 'i',
+	'κ': 'k',
+	'ν': 'v',
+	'ο': 'o',
+	'ρ': 'p',
+	'τ': 't',
+	'υ': 'u',
+	'χ': 'x',
+}
+
+// 화면에 표시되는 문자열의 폭을 계산합니다
+// 한글, 한자, 가나 등 동아시아 전각 문자는 2, 그 외 문자는 1로 계산합니다
+func DisplayWidth(text string) int {
+	w := 0
+	for _, r := range text {
+		w += RuneWidth(r)
+	}
+	return w
+}
+
+// 화면에 표시되는 문자의 폭을 반환합니다
+func RuneWidth(r rune) int {
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
 	default:
-		return specialCharPatternEnglish.MatchString(text)
+		return 1
+	}
+}
+
+// 이름 중복 비교용 정규 키를 만듭니다 (NFKC 정규화 후 대소문자 통합, Unicode NFKC_Casefold)
+// "Hero", "hero", 전각 "Ｈｅｒｏ"는 모두 같은 키가 됩니다
+func CanonicalName(text string) string {
+	return norm.NFKC.String(cases.Fold().String(norm.NFKC.String(text)))
+}
+
+// 필터 비교용으로 문자열을 정규화합니다
+// NFKC 정규화와 대소문자 통합 후 모양이 비슷한 문자를 라틴 문자로 바꾸고, 문자와 숫자가 아닌 구분 문자는 제거합니다
+func FoldConfusable(text string) string {
+	text = cases.Fold().String(norm.NFKC.String(text))
+
+	var b strings.Builder
+	b.Grow(len(text))
+	for _, r := range text {
+		if c, ok := confusableRunes[r]; ok {
+			r = c
+		}
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			b.WriteRune(r)
+		}
 	}
+	return b.String()
 }
@@ -0,0 +1,69 @@
+package util_test
+
+import (
+	"MScannot206/shared/util"
+	"testing"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		text  string
+		width int
+	}{
+		{"", 0},
+		{"abc123", 6},
+		{"토벤머리", 8},
+		{"토벤1", 5},
+		{"ひらがなカタカナ", 16},
+		{"漢字", 4},
+		{"ＧＭ", 4},
+	}
+
+	for _, tt := range tests {
+		if got := util.DisplayWidth(tt.text); got != tt.width {
+			t.Errorf("DisplayWidth(%q) = %d, want %d", tt.text, got, tt.width)
+		}
+	}
+}
+
+func TestFoldConfusable(t *testing.T) {
+	tests := []struct {
+		text   string
+		folded string
+	}{
+		{"Admin", "admin"},
+		{"ＡＤＭＩＮ", "admin"},
+		{"4dm1n", "admin"},
+		{"a.d-m_i n", "admin"},
+		{"аdmіn", "admin"},
+		{"G0d", "god"},
+		{"토벤머리", "토벤머리"},
+		{"ｸｿ", "クソ"},
+	}
+
+	for _, tt := range tests {
+		if got := util.FoldConfusable(tt.text); got != tt.folded {
+			t.Errorf("FoldConfusable(%q) = %q, want %q", tt.text, got, tt.folded)
+		}
+	}
+}
+
+func TestCanonicalName(t *testing.T) {
+	tests := []struct {
+		text string
+		key  string
+	}{
+		{"Hero", "hero"},
+		{"HERO", "hero"},
+		{"Ｈｅｒｏ", "hero"},
+		{"토벤머리", "토벤머리"},
+		{"ﾄﾍﾞﾝ", "トベン"},
+		{"Straße", "strasse"},
+	}
+
+	for _, tt := range tests {
+		if got := util.CanonicalName(tt.text); got != tt.key {
+			t.Errorf("CanonicalName(%q) = %q, want %q", tt.text, got, tt.key)
+		}
+	}
+}
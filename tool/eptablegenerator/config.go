@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// 생성기 설정 (경로는 설정 파일 기준 상대 경로입니다)
+type config struct {
+	// 생성할 코드의 패키지 이름
+	PackageName string `yaml:"package_name"`
+
+	// 기획 데이터(xlsx) 디렉토리
+	SourceDir string `yaml:"source_dir"`
+
+	// 테이블 코드 출력 디렉토리
+	DestDir string `yaml:"dest_dir"`
+
+	// CSV 출력 디렉토리
+	CsvDir string `yaml:"csv_dir"`
+
+	// enum 컬럼의 go_type이 사용하는 패키지 (키는 패키지 이름, 값은 import 경로)
+	Imports map[string]string `yaml:"imports"`
+
+	// 테이블별 옵션 (키는 시트 이름)
+	Tables map[string]tableConfig `yaml:"tables"`
+}
+
+type tableConfig struct {
+	// 컬럼별 옵션 (키는 컬럼 이름)
+	Columns map[string]columnConfig `yaml:"columns"`
+
+	// 로드 시 미리 모아둘 레코드 조건 (설정 순서를 유지합니다)
+	Filters filterConfigs `yaml:"filters"`
+
+	// 컬럼 값별로 레코드를 묶을 컬럼
+	Indexes []string `yaml:"indexes"`
+}
+
+type filterConfig struct {
+	// Get<Name>Records()로 제공할 이름
+	Name string
+
+	// 조건 (Go 표현식, rec는 레코드)
+	Expr string
+}
+
+// 이름: 조건 형식의 매핑을 설정 순서대로 읽습니다
+type filterConfigs []filterConfig
+
+func (f *filterConfigs) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: filters must be a mapping", value.Line)
+	}
+
+	for i := 0; i+1 < len(value.Content); i += 2 {
+		var filter filterConfig
+		if err := value.Content[i].Decode(&filter.Name); err != nil {
+			return err
+		}
+		if err := value.Content[i+1].Decode(&filter.Expr); err != nil {
+			return err
+		}
+		*f = append(*f, filter)
+	}
+	return nil
+}
+
+type columnConfig struct {
+	// 빈 값을 허용합니다 (빈 값은 Default를 사용합니다)
+	Nullable bool `yaml:"nullable"`
+
+	// Nullable 컬럼의 기본값 (생략 시 타입의 기본값)
+	Default string `yaml:"default"`
+
+	// 시트의 타입 대신 사용할 컬럼 타입 ("[]string", bool, enum)
+	Type string `yaml:"type"`
+
+	// enum 컬럼의 Go 타입 (예: types.ItemCategory)
+	GoType string `yaml:"go_type"`
+
+	// enum 컬럼 값을 검증하는 func(string) (T, bool) 함수 (예: types.ParseItemCategory)
+	Parser string `yaml:"parser"`
+}
+
+func loadConfig(path string) (*config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	cfg := &config{}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil {
+		return nil, err
+	}
+
+	if cfg.PackageName == "" || cfg.SourceDir == "" || cfg.DestDir == "" || cfg.CsvDir == "" {
+		return nil, fmt.Errorf("package_name, source_dir, dest_dir, csv_dir must be set")
+	}
+
+	baseDir := filepath.Dir(path)
+	for _, dir := range []*string{&cfg.SourceDir, &cfg.DestDir, &cfg.CsvDir} {
+		if !filepath.IsAbs(*dir) {
+			*dir = filepath.Join(baseDir, *dir)
+		}
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+const generatedHeader = "// Code generated by eptablegenerator. DO NOT EDIT."
+
+var codeTemplate = template.Must(template.New("table").Parse(`{{.Header}}
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+
+func init() {
+	Register("{{.Name}}", "{{.Name}}.csv", func() Table { return New{{.Name}}Table() })
+}
+
+func New{{.Name}}Table() *{{.Name}}Table {
+	return &{{.Name}}Table{records: make(map[{{.Key.GoType}}]*{{.Name}}Record, {{len .Rows}}), order: make([]*{{.Name}}Record, 0, {{len .Rows}}){{range .Indexes}}, by{{.Name}}: make(map[{{.GoType}}][]{{$.Name}}Record){{end}}}
+}
+
+type {{.Name}}Table struct {
+	records map[{{.Key.GoType}}]*{{.Name}}Record
+
+	order []*{{.Name}}Record
+{{- range .Filters}}
+
+	{{.Field}} []{{$.Name}}Record
+{{- end}}
+{{- range .Indexes}}
+
+	by{{.Name}} map[{{.GoType}}][]{{$.Name}}Record
+{{- end}}
+}
+
+type {{.Name}}Record struct {
+{{- range $i, $c := .Columns}}
+{{- if $i}}
+{{end}}
+	{{$c.Name}} {{$c.GoType}}
+{{- end}}
+}
+
+func (t *{{.Name}}Table) Load(csvPath string) error {
+	reader, err := newCsvTableReader(csvPath{{range .Columns}}, "{{.Name}}"{{end}})
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for row := range reader.Rows() {
+		rec := &{{.Name}}Record{}
+{{- range .Columns}}
+		rec.{{.Name}} = {{.ReadExpr}}
+{{- end}}
+		if err := row.Err(); err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+
+		if _, ok := t.records[rec.{{.Key.Name}}]; ok {
+			errs = errors.Join(errs, row.Error("{{.Key.Name}}", ErrDuplicateKey))
+			continue
+		}
+		t.records[rec.{{.Key.Name}}] = rec
+		t.order = append(t.order, rec)
+{{- range .Filters}}
+		if {{.Expr}} {
+			t.{{.Field}} = append(t.{{.Field}}, *rec)
+		}
+{{- end}}
+{{- range .Indexes}}
+		t.by{{.Name}}[rec.{{.Name}}] = append(t.by{{.Name}}[rec.{{.Name}}], *rec)
+{{- end}}
+	}
+	return errs
+}
+
+func (t *{{.Name}}Table) Get(key {{.Key.GoType}}) ({{.Name}}Record, bool) {
+	rec, ok := t.records[key]
+	if !ok {
+		return {{.Name}}Record{}, false
+	}
+	return *rec, true
+}
+
+func (t *{{.Name}}Table) GetAll() []{{.Name}}Record {
+	all := make([]{{.Name}}Record, 0, len(t.records))
+	for _, rec := range t.order {
+		all = append(all, *rec)
+	}
+	return all
+}
+
+func (t *{{.Name}}Table) All() iter.Seq[{{.Name}}Record] {
+	return func(yield func({{.Name}}Record) bool) {
+		for _, rec := range t.order {
+			if !yield(*rec) {
+				return
+			}
+		}
+	}
+}
+
+func (t *{{.Name}}Table) Len() int {
+	return len(t.order)
+}
+{{- range .Filters}}
+
+func (t *{{$.Name}}Table) Get{{.Name}}Records() []{{$.Name}}Record {
+	return t.{{.Field}}
+}
+{{- end}}
+{{- range .Indexes}}
+
+func (t *{{$.Name}}Table) GetBy{{.Name}}(key {{.GoType}}) []{{$.Name}}Record {
+	return t.by{{.Name}}[key]
+}
+{{- end}}
+`))
+
+// 설정의 source_dir에 있는 모든 xlsx 파일을 읽어 테이블 정의를 만듭니다
+func loadTables(cfg *config) ([]*tableDef, error) {
+	files, err := filepath.Glob(filepath.Join(cfg.SourceDir, "*.xlsx"))
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(files)
+
+	var tables []*tableDef
+	seen := make(map[string]string)
+	for _, file := range files {
+		// 엑셀이 열려 있는 동안 만드는 잠금 파일은 건너뜁니다
+		if strings.HasPrefix(filepath.Base(file), "~$") {
+			continue
+		}
+
+		sheets, err := readXlsx(file)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", filepath.Base(file), err)
+		}
+
+		for _, sheet := range sheets {
+			if prev, ok := seen[sheet.Name]; ok {
+				return nil, fmt.Errorf("%v: sheet %v already defined in %v", filepath.Base(file), sheet.Name, prev)
+			}
+			seen[sheet.Name] = filepath.Base(file)
+
+			def, err := newTableDef(file, sheet, cfg.Tables[sheet.Name])
+			if err != nil {
+				return nil, err
+			}
+			tables = append(tables, def)
+		}
+	}
+
+	for name := range cfg.Tables {
+		if _, ok := seen[name]; !ok {
+			return nil, fmt.Errorf("table %v in config not found in %v", name, cfg.SourceDir)
+		}
+	}
+
+	return tables, nil
+}
+
+// 테이블 코드를 만듭니다
+func renderCode(cfg *config, def *tableDef) ([]byte, error) {
+	imports := []string{"errors", "iter"}
+	for _, column := range def.Columns {
+		pkg := column.Package()
+		if pkg == "" {
+			continue
+		}
+
+		path, ok := cfg.Imports[pkg]
+		if !ok {
+			return nil, def.errorf(0, column.Name, "import for package %v not found in config", pkg)
+		}
+		if !slices.Contains(imports, path) {
+			imports = append(imports, path)
+		}
+	}
+	slices.Sort(imports)
+
+	var buf bytes.Buffer
+	err := codeTemplate.Execute(&buf, struct {
+		*tableDef
+		Header  string
+		Package string
+		Imports []string
+	}{
+		tableDef: def,
+		Header:   generatedHeader,
+		Package:  cfg.PackageName,
+		Imports:  imports,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", def.Name, err)
+	}
+	return src, nil
+}
+
+// 테이블 코드와 CSV를 생성하고 생성한 테이블 이름을 반환합니다 (only가 비어 있으면 모든 테이블을 생성합니다)
+func generate(cfg *config, only []string) ([]string, error) {
+	tables, err := loadTables(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range only {
+		if !slices.ContainsFunc(tables, func(def *tableDef) bool { return def.Name == name }) {
+			return nil, fmt.Errorf("table %v not found in %v", name, cfg.SourceDir)
+		}
+	}
+
+	var generated []string
+	for _, def := range tables {
+		if len(only) > 0 && !slices.Contains(only, def.Name) {
+			continue
+		}
+
+		code, err := renderCode(cfg, def)
+		if err != nil {
+			return nil, err
+		}
+		csvData, err := def.csv()
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", def.Name, err)
+		}
+
+		if err := os.WriteFile(filepath.Join(cfg.DestDir, def.Name+".go"), code, 0o644); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(filepath.Join(cfg.CsvDir, def.Name+".csv"), csvData, 0o644); err != nil {
+			return nil, err
+		}
+		generated = append(generated, def.Name)
+	}
+
+	return generated, nil
+}
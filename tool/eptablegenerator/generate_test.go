@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	cfg, err := loadConfig("config.yml")
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	tables, err := loadTables(cfg)
+	if err != nil {
+		t.Fatalf("failed to load tables: %v", err)
+	}
+
+	// 시트나 설정을 고친 뒤 다시 생성하지 않았거나 생성된 파일을 직접 수정하면 실패합니다
+	for _, def := range tables {
+		code, err := renderCode(cfg, def)
+		if err != nil {
+			t.Fatalf("failed to render %v: %v", def.Name, err)
+		}
+		csvData, err := def.csv()
+		if err != nil {
+			t.Fatalf("failed to render %v csv: %v", def.Name, err)
+		}
+
+		for path, want := range map[string][]byte{
+			filepath.Join(cfg.DestDir, def.Name+".go"): code,
+			filepath.Join(cfg.CsvDir, def.Name+".csv"): csvData,
+		} {
+			got, err := os.ReadFile(path)
+			if err != nil {
+				t.Errorf("failed to read %v: %v", path, err)
+				continue
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%v is out of date, run go run . in tool/eptablegenerator", path)
+			}
+		}
+	}
+}
+
+func testSheet(rows ...[]string) xlsxSheet {
+	sheet := xlsxSheet{Name: "Test"}
+	for i, values := range rows {
+		sheet.Rows = append(sheet.Rows, xlsxRow{Num: i + 1, Values: values})
+	}
+	return sheet
+}
+
+func TestNewTableDef(t *testing.T) {
+	sheet := testSheet(
+		[]string{"Index", "확률", "Count", "Tags", "Kind"},
+		[]string{"string", "number", "integer", "string", "string"},
+		[]string{"key", "design", "all", "all", "all"},
+		[]string{"a", "0.5", "", "x,y", "k1"},
+		[]string{"", "", "", "", ""},
+		[]string{"b", "0.5", "3", "", "k2"},
+	)
+	cfg := tableConfig{
+		Columns: map[string]columnConfig{
+			"Count": {Nullable: true, Default: "1"},
+			"Tags":  {Type: "[]string"},
+			"Kind":  {Type: "enum", GoType: "types.Kind", Parser: "types.ParseKind"},
+		},
+		Filters: filterConfigs{{Name: "Tagged", Expr: "len(rec.Tags) > 0"}},
+		Indexes: []string{"Kind"},
+	}
+
+	def, err := newTableDef("test.xlsx", sheet, cfg)
+	if err != nil {
+		t.Fatalf("failed to create table def: %v", err)
+	}
+
+	// design 컬럼과 빈 행은 내보내지 않습니다
+	if len(def.Columns) != 4 || len(def.Rows) != 2 {
+		t.Fatalf("expected 4 columns and 2 rows, got %d and %d", len(def.Columns), len(def.Rows))
+	}
+
+	want := map[string]string{
+		"Index": `row.Key("Index")`,
+		"Count": `row.IntOr("Count", 1)`,
+		"Tags":  `row.StringList("Tags")`,
+		"Kind":  `rowEnum(row, "Kind", types.ParseKind)`,
+	}
+	for _, column := range def.Columns {
+		if got := column.ReadExpr(); got != want[column.Name] {
+			t.Errorf("%v: expected %v, got %v", column.Name, want[column.Name], got)
+		}
+	}
+
+	if len(def.Filters) != 1 || def.Filters[0].Field != "taggedRecords" {
+		t.Errorf("unexpected filters: %+v", def.Filters)
+	}
+	if len(def.Indexes) != 1 || def.Indexes[0].GoType != "types.Kind" {
+		t.Errorf("unexpected indexes: %+v", def.Indexes)
+	}
+}
+
+func TestNewTableDefErrors(t *testing.T) {
+	header := [][]string{
+		{"Index", "Count", "Tags"},
+		{"string", "integer", "string"},
+		{"key", "all", "all"},
+	}
+
+	tests := []struct {
+		name string
+		cfg  tableConfig
+		want string
+	}{
+		{"unknown column", tableConfig{Columns: map[string]columnConfig{"Missing": {Nullable: true}}}, "column in config not found"},
+		{"nullable key", tableConfig{Columns: map[string]columnConfig{"Index": {Nullable: true}}}, "nullable is not supported"},
+		{"invalid default", tableConfig{Columns: map[string]columnConfig{"Count": {Nullable: true, Default: "x"}}}, "invalid default"},
+		{"enum without parser", tableConfig{Columns: map[string]columnConfig{"Tags": {Type: "enum", GoType: "types.Kind"}}}, "enum requires go_type and parser"},
+		{"invalid filter", tableConfig{Filters: filterConfigs{{Name: "Bad", Expr: "rec.Count >"}}}, "invalid expression"},
+		{"list index", tableConfig{Columns: map[string]columnConfig{"Tags": {Type: "[]string"}}, Indexes: []string{"Tags"}}, "cannot be indexed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newTableDef("test.xlsx", testSheet(header...), tt.cfg)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
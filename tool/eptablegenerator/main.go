@@ -0,0 +1,37 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// 기획 데이터(table/*.xlsx)로부터 CSV(data/*.csv)와 테이블 코드(shared/table/*.go)를 생성합니다
+// tool/eptablegenerator 디렉토리에서 go run . 으로 실행합니다
+func main() {
+	var configPath = flag.String("config", "config.yml", "생성기 설정 파일 경로 지정")
+	var tables = flag.String("table", "", "생성할 테이블 이름 지정 (쉼표로 구분, 생략 시 모든 테이블)")
+	flag.Parse()
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "설정 파일 로드 오류 [path:%v]: %v\n", *configPath, err)
+		os.Exit(1)
+	}
+
+	var only []string
+	if *tables != "" {
+		only = strings.Split(*tables, ",")
+	}
+
+	generated, err := generate(cfg, only)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "테이블 생성 오류: %v\n", err)
+		os.Exit(1)
+	}
+
+	for _, name := range generated {
+		fmt.Printf("테이블 생성 완료 [table:%v]\n", name)
+	}
+}
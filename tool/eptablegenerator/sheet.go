@@ -0,0 +1,370 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// 시트는 1행 컬럼 이름, 2행 타입, 3행 내보내기 옵션, 4행부터 데이터로 구성됩니다
+const (
+	sheetNameRow   = 1
+	sheetTypeRow   = 2
+	sheetExportRow = 3
+	sheetDataRow   = 4
+)
+
+// 시트 컬럼 타입별 Go 타입
+var sheetTypes = map[string]string{
+	"string":  "string",
+	"number":  "float64",
+	"integer": "int64",
+	"boolean": "bool",
+}
+
+type tableDef struct {
+	// 시트(테이블) 이름
+	Name string
+
+	// 시트가 있는 xlsx 파일 이름
+	File string
+
+	// CSV로 내보내는 컬럼 (시트 순서)
+	Columns []*columnDef
+
+	// 키 컬럼
+	Key *columnDef
+
+	// 로드 시 미리 모아두는 레코드 조건
+	Filters []filterDef
+
+	// 컬럼 값별로 레코드를 묶는 컬럼
+	Indexes []*columnDef
+
+	// 내보내는 컬럼 값 (빈 행 제외)
+	Rows [][]string
+}
+
+type filterDef struct {
+	// Get<Name>Records()로 제공할 이름
+	Name string
+
+	// 레코드를 모아두는 테이블 필드 이름
+	Field string
+
+	// 조건 (Go 표현식, rec는 레코드)
+	Expr string
+}
+
+type columnDef struct {
+	Name string
+
+	// 시트의 컬럼 번호 (0부터 시작)
+	index int
+
+	// 키 컬럼입니다
+	IsKey bool
+
+	// 레코드 필드의 Go 타입
+	GoType string
+
+	// 빈 값을 허용합니다
+	Nullable bool
+
+	// 빈 값일 때 사용하는 Go 리터럴
+	Default string
+
+	// enum 컬럼 값을 검증하는 함수
+	Parser string
+}
+
+// 시트를 읽어 테이블 정의를 만듭니다
+func newTableDef(file string, sheet xlsxSheet, cfg tableConfig) (*tableDef, error) {
+	def := &tableDef{Name: sheet.Name, File: filepath.Base(file)}
+	if !token.IsIdentifier(def.Name) || !token.IsExported(def.Name) {
+		return nil, def.errorf(0, "", "invalid table name")
+	}
+
+	header := make(map[int][]string, sheetExportRow)
+	for _, row := range sheet.Rows {
+		if row.Num <= sheetExportRow {
+			header[row.Num] = row.Values
+		}
+	}
+	cell := func(rowNum int, col int) string {
+		if col < len(header[rowNum]) {
+			return strings.TrimSpace(header[rowNum][col])
+		}
+		return ""
+	}
+
+	names := make(map[string]bool)
+	for col := range header[sheetNameRow] {
+		name := cell(sheetNameRow, col)
+		export := cell(sheetExportRow, col)
+		if name == "" && export == "" {
+			continue
+		}
+
+		switch export {
+		case "design":
+			continue
+		case "key", "all":
+		default:
+			return nil, def.errorf(sheetExportRow, name, "invalid export option %q", export)
+		}
+
+		if !token.IsIdentifier(name) {
+			return nil, def.errorf(sheetNameRow, name, "invalid column name")
+		}
+		if names[name] {
+			return nil, def.errorf(sheetNameRow, name, "duplicate column")
+		}
+		names[name] = true
+
+		goType, ok := sheetTypes[cell(sheetTypeRow, col)]
+		if !ok {
+			return nil, def.errorf(sheetTypeRow, name, "invalid column type %q", cell(sheetTypeRow, col))
+		}
+
+		column := &columnDef{Name: name, index: col, GoType: goType}
+		def.Columns = append(def.Columns, column)
+
+		if export == "key" {
+			if def.Key != nil {
+				return nil, def.errorf(sheetExportRow, name, "multiple key columns")
+			}
+			def.Key = column
+			column.IsKey = true
+		}
+	}
+	if def.Key == nil {
+		return nil, def.errorf(sheetExportRow, "", "key column not found")
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(cfg.Columns)) {
+		opt := cfg.Columns[name]
+		column := def.column(name)
+		if column == nil {
+			return nil, def.errorf(0, name, "column in config not found")
+		}
+		if err := column.apply(opt); err != nil {
+			return nil, def.errorf(0, name, "%v", err)
+		}
+	}
+	if def.Key.Nullable {
+		return nil, def.errorf(0, def.Key.Name, "key column cannot be nullable")
+	}
+	if def.Key.Parser == "" && def.Key.GoType != "string" && def.Key.GoType != "int64" {
+		return nil, def.errorf(0, def.Key.Name, "%v key column is not supported", def.Key.GoType)
+	}
+
+	for _, filter := range cfg.Filters {
+		if !token.IsIdentifier(filter.Name) || !token.IsExported(filter.Name) {
+			return nil, def.errorf(0, "", "invalid filter name %q", filter.Name)
+		}
+		if slices.ContainsFunc(def.Filters, func(f filterDef) bool { return f.Name == filter.Name }) {
+			return nil, def.errorf(0, "", "duplicate filter %v", filter.Name)
+		}
+		if _, err := parser.ParseExpr(filter.Expr); err != nil {
+			return nil, def.errorf(0, "", "filter %v: invalid expression %q: %v", filter.Name, filter.Expr, err)
+		}
+
+		def.Filters = append(def.Filters, filterDef{
+			Name:  filter.Name,
+			Field: strings.ToLower(filter.Name[:1]) + filter.Name[1:] + "Records",
+			Expr:  filter.Expr,
+		})
+	}
+
+	for _, name := range cfg.Indexes {
+		column := def.column(name)
+		if column == nil {
+			return nil, def.errorf(0, name, "index column not found")
+		}
+		if column.GoType == "[]string" {
+			return nil, def.errorf(0, name, "%v column cannot be indexed", column.GoType)
+		}
+		if slices.Contains(def.Indexes, column) {
+			return nil, def.errorf(0, name, "duplicate index")
+		}
+		def.Indexes = append(def.Indexes, column)
+	}
+
+	for _, row := range sheet.Rows {
+		if row.Num < sheetDataRow {
+			continue
+		}
+
+		values := make([]string, len(def.Columns))
+		empty := true
+		for i, column := range def.Columns {
+			if column.index < len(row.Values) {
+				values[i] = row.Values[column.index]
+			}
+			if values[i] != "" {
+				empty = false
+			}
+		}
+		if !empty {
+			def.Rows = append(def.Rows, values)
+		}
+	}
+
+	return def, nil
+}
+
+func (def *tableDef) column(name string) *columnDef {
+	for _, column := range def.Columns {
+		if column.Name == name {
+			return column
+		}
+	}
+	return nil
+}
+
+func (def *tableDef) errorf(row int, column string, format string, args ...any) error {
+	msg := fmt.Sprintf(format, args...)
+	switch {
+	case row == 0 && column == "":
+		return fmt.Errorf("%s:%s: %s", def.File, def.Name, msg)
+	case row == 0:
+		return fmt.Errorf("%s:%s:%s: %s", def.File, def.Name, column, msg)
+	default:
+		return fmt.Errorf("%s:%s:%d:%s: %s", def.File, def.Name, row, column, msg)
+	}
+}
+
+// CSV 파일 내용을 만듭니다
+func (def *tableDef) csv() ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	header := make([]string, len(def.Columns))
+	for i, column := range def.Columns {
+		header[i] = column.Name
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+	if err := w.WriteAll(def.Rows); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// 컬럼 옵션을 적용합니다
+func (c *columnDef) apply(opt columnConfig) error {
+	if opt.Type != "enum" && (opt.GoType != "" || opt.Parser != "") {
+		return fmt.Errorf("go_type and parser require enum type")
+	}
+
+	switch opt.Type {
+	case "":
+	case "[]string", "bool":
+		c.GoType = opt.Type
+	case "enum":
+		if !isQualifiedIdent(opt.GoType) || !isQualifiedIdent(opt.Parser) {
+			return fmt.Errorf("enum requires go_type and parser (e.g. types.ItemCategory, types.ParseItemCategory)")
+		}
+		c.GoType = opt.GoType
+		c.Parser = opt.Parser
+	default:
+		return fmt.Errorf("invalid type %q", opt.Type)
+	}
+
+	if !opt.Nullable {
+		if opt.Default != "" {
+			return fmt.Errorf("default requires nullable")
+		}
+		return nil
+	}
+
+	c.Nullable = true
+	switch c.GoType {
+	case "int64":
+		c.Default = "0"
+		if opt.Default != "" {
+			if _, err := strconv.ParseInt(opt.Default, 10, 64); err != nil {
+				return fmt.Errorf("invalid default %q", opt.Default)
+			}
+			c.Default = opt.Default
+		}
+	case "float64":
+		c.Default = "0"
+		if opt.Default != "" {
+			if _, err := strconv.ParseFloat(opt.Default, 64); err != nil {
+				return fmt.Errorf("invalid default %q", opt.Default)
+			}
+			c.Default = opt.Default
+		}
+	case "bool":
+		c.Default = "false"
+		if opt.Default != "" {
+			boolVal, err := strconv.ParseBool(opt.Default)
+			if err != nil {
+				return fmt.Errorf("invalid default %q", opt.Default)
+			}
+			c.Default = strconv.FormatBool(boolVal)
+		}
+	default:
+		return fmt.Errorf("nullable is not supported for %v column", c.GoType)
+	}
+	return nil
+}
+
+// 레코드 필드 값을 읽는 Load 코드의 표현식
+func (c *columnDef) ReadExpr() string {
+	name := strconv.Quote(c.Name)
+	if c.Parser != "" {
+		return "rowEnum(row, " + name + ", " + c.Parser + ")"
+	}
+
+	switch c.GoType {
+	case "string":
+		if c.IsKey {
+			return "row.Key(" + name + ")"
+		}
+		return "row.String(" + name + ")"
+	case "int64":
+		if c.Nullable {
+			return "row.IntOr(" + name + ", " + c.Default + ")"
+		}
+		return "row.Int(" + name + ")"
+	case "float64":
+		if c.Nullable {
+			return "row.FloatOr(" + name + ", " + c.Default + ")"
+		}
+		return "row.Float(" + name + ")"
+	case "bool":
+		if c.Nullable {
+			return "row.BoolOr(" + name + ", " + c.Default + ")"
+		}
+		return "row.Bool(" + name + ")"
+	case "[]string":
+		return "row.StringList(" + name + ")"
+	}
+	return ""
+}
+
+// 컬럼 타입이 사용하는 패키지 이름 (다른 패키지의 타입이 아니면 빈 문자열)
+func (c *columnDef) Package() string {
+	if pkg, _, ok := strings.Cut(c.GoType, "."); ok {
+		return pkg
+	}
+	return ""
+}
+
+// pkg.Name 형식인지 확인합니다
+func isQualifiedIdent(s string) bool {
+	pkg, name, ok := strings.Cut(s, ".")
+	return ok && token.IsIdentifier(pkg) && token.IsIdentifier(name)
+}
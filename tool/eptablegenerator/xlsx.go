@@ -0,0 +1,213 @@
+package main
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// xlsx 파일의 시트 이름과 셀 값을 읽습니다 (서식과 수식은 무시하고 저장된 값만 사용합니다)
+
+type xlsxSheet struct {
+	// 시트 이름
+	Name string
+
+	// 행 번호(1부터 시작)와 셀 값
+	Rows []xlsxRow
+}
+
+type xlsxRow struct {
+	Num    int
+	Values []string
+}
+
+type xlsxWorkbookXml struct {
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+		Id   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelsXml struct {
+	Relationships []struct {
+		Id     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxRichTextXml struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxRichTextXml) String() string {
+	if len(t.Runs) == 0 {
+		return t.T
+	}
+
+	var sb strings.Builder
+	for _, r := range t.Runs {
+		sb.WriteString(r.T)
+	}
+	return sb.String()
+}
+
+type xlsxSharedStringsXml struct {
+	Items []xlsxRichTextXml `xml:"si"`
+}
+
+type xlsxWorksheetXml struct {
+	Rows []struct {
+		R     int `xml:"r,attr"`
+		Cells []struct {
+			R  string           `xml:"r,attr"`
+			T  string           `xml:"t,attr"`
+			V  string           `xml:"v"`
+			Is *xlsxRichTextXml `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// xlsx 파일의 모든 시트를 워크북 순서대로 읽습니다
+func readXlsx(filePath string) ([]xlsxSheet, error) {
+	zr, err := zip.OpenReader(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	var workbook xlsxWorkbookXml
+	if err := readXml(files, "xl/workbook.xml", &workbook); err != nil {
+		return nil, err
+	}
+
+	var rels xlsxRelsXml
+	if err := readXml(files, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return nil, err
+	}
+	targets := make(map[string]string, len(rels.Relationships))
+	for _, rel := range rels.Relationships {
+		if strings.HasPrefix(rel.Target, "/") {
+			targets[rel.Id] = strings.TrimPrefix(rel.Target, "/")
+		} else {
+			targets[rel.Id] = path.Join("xl", rel.Target)
+		}
+	}
+
+	var sharedStrings xlsxSharedStringsXml
+	if _, ok := files["xl/sharedStrings.xml"]; ok {
+		if err := readXml(files, "xl/sharedStrings.xml", &sharedStrings); err != nil {
+			return nil, err
+		}
+	}
+
+	sheets := make([]xlsxSheet, 0, len(workbook.Sheets))
+	for _, s := range workbook.Sheets {
+		target, ok := targets[s.Id]
+		if !ok {
+			return nil, fmt.Errorf("sheet %v: relationship %v not found", s.Name, s.Id)
+		}
+
+		var worksheet xlsxWorksheetXml
+		if err := readXml(files, target, &worksheet); err != nil {
+			return nil, fmt.Errorf("sheet %v: %w", s.Name, err)
+		}
+
+		sheet := xlsxSheet{Name: s.Name, Rows: make([]xlsxRow, 0, len(worksheet.Rows))}
+		for i, r := range worksheet.Rows {
+			row := xlsxRow{Num: r.R}
+			if row.Num == 0 {
+				row.Num = i + 1
+			}
+
+			for j, c := range r.Cells {
+				col := j
+				if c.R != "" {
+					if col, err = columnIndex(c.R); err != nil {
+						return nil, fmt.Errorf("sheet %v: %w", s.Name, err)
+					}
+				}
+
+				var v string
+				switch c.T {
+				case "s":
+					var idx int
+					if _, err := fmt.Sscan(c.V, &idx); err != nil || idx < 0 || idx >= len(sharedStrings.Items) {
+						return nil, fmt.Errorf("sheet %v: cell %v: invalid shared string %q", s.Name, c.R, c.V)
+					}
+					v = sharedStrings.Items[idx].String()
+				case "inlineStr":
+					if c.Is != nil {
+						v = c.Is.String()
+					}
+				case "b":
+					if c.V == "1" {
+						v = "TRUE"
+					} else {
+						v = "FALSE"
+					}
+				default:
+					v = c.V
+				}
+
+				for len(row.Values) <= col {
+					row.Values = append(row.Values, "")
+				}
+				row.Values[col] = v
+			}
+			sheet.Rows = append(sheet.Rows, row)
+		}
+		sheets = append(sheets, sheet)
+	}
+
+	return sheets, nil
+}
+
+func readXml(files map[string]*zip.File, name string, v any) error {
+	f, ok := files[name]
+	if !ok {
+		return fmt.Errorf("%v not found", name)
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return err
+	}
+	if err := xml.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%v: %w", name, err)
+	}
+	return nil
+}
+
+// 셀 참조(예: AB12)의 컬럼 번호(0부터 시작)를 반환합니다
+func columnIndex(ref string) (int, error) {
+	col := 0
+	n := 0
+	for _, ch := range ref {
+		if ch < 'A' || ch > 'Z' {
+			break
+		}
+		col = col*26 + int(ch-'A'+1)
+		n++
+	}
+	if n == 0 {
+		return 0, fmt.Errorf("invalid cell reference %q", ref)
+	}
+	return col - 1, nil
+}